@@ -10,7 +10,7 @@ import (
 	"github.com/mhrdini/godsa/datastructures/queues/linkedlistqueue"
 )
 
-func Run(g datastructures.Graph, src int) []*graphs.Vertex {
+func Run(g datastructures.Topology, src int) []*graphs.Vertex {
 	fmt.Printf("Running BFS on the following graph:\n%v\n\n", g)
 
 	vertices := make([]*graphs.Vertex, g.Size())
@@ -0,0 +1,38 @@
+package centrality
+
+import (
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Betweenness computes the betweenness centrality of every vertex using
+// Brandes' algorithm: the number of shortest paths between other pairs of
+// vertices that pass through it, where pairs sharing several shortest paths
+// contribute fractionally.
+//
+// When weighted is false every edge has length 1 and a BFS is run from each
+// vertex in O(VE); otherwise edge weights are used as lengths with Dijkstra.
+// In undirected graphs each unordered pair is counted once.
+func Betweenness[W graphs.Number](g graphs.WeightedGraph[W], weighted bool) []float64 {
+	n := g.Size()
+	cb := make([]float64, n)
+
+	for s := 0; s < n; s++ {
+		sp := search(g, s, weighted)
+		delta := make([]float64, n)
+		for w, ok := sp.order.Pop(); ok; w, ok = sp.order.Pop() {
+			for _, v := range sp.preds[w] {
+				delta[v] += sp.sigma[v] / sp.sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				cb[w] += delta[w]
+			}
+		}
+	}
+
+	if g.Undirected() {
+		for v := range cb {
+			cb[v] /= 2
+		}
+	}
+	return cb
+}
@@ -0,0 +1,121 @@
+package centrality
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/queues/linkedlistqueue"
+	"github.com/mhrdini/godsa/datastructures/stacks/linkedliststack"
+	"github.com/mhrdini/godsa/datastructures/trees/heaps/binaryheap"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+)
+
+// Centrality measures assign a score to every vertex of a graph describing how
+// important that vertex is. Every measure returns a slice indexed by vertex.
+
+// shortestPaths is the result of a single-source shortest path search that
+// keeps track of every shortest path rather than just one of them.
+type shortestPaths struct {
+	dist  []float64 // distance from the source, +Inf if unreachable
+	sigma []float64 // number of shortest paths from the source
+	preds [][]int   // predecessors of each vertex on shortest paths
+	order *linkedliststack.Stack[int]
+}
+
+type item struct {
+	v    int
+	dist float64
+}
+
+func compareItems(a, b item) int {
+	return comparator.OrderedComparator(a.dist, b.dist)
+}
+
+// weight returns the weight of the edge (u, v), or 1 when weights are ignored.
+func weight[W graphs.Number](g graphs.WeightedGraph[W], u, v int, weighted bool) float64 {
+	if !weighted {
+		return 1
+	}
+	w, _ := g.Weight(u, v)
+	return float64(w)
+}
+
+// search runs BFS (unweighted) or Dijkstra (weighted) from src. Vertices are
+// pushed onto order in non-decreasing distance from src.
+func search[W graphs.Number](g graphs.WeightedGraph[W], src int, weighted bool) *shortestPaths {
+	n := g.Size()
+	sp := &shortestPaths{
+		dist:  make([]float64, n),
+		sigma: make([]float64, n),
+		preds: make([][]int, n),
+		order: linkedliststack.New[int](),
+	}
+	for i := range sp.dist {
+		sp.dist[i] = math.Inf(1)
+	}
+	sp.dist[src] = 0
+	sp.sigma[src] = 1
+
+	if !weighted {
+		q := linkedlistqueue.New(src)
+		for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+			sp.order.Push(u)
+			for _, v := range g.Neighbors(u) {
+				if math.IsInf(sp.dist[v], 1) {
+					sp.dist[v] = sp.dist[u] + 1
+					q.Enqueue(v)
+				}
+				if sp.dist[v] == sp.dist[u]+1 {
+					sp.sigma[v] += sp.sigma[u]
+					sp.preds[v] = append(sp.preds[v], u)
+				}
+			}
+		}
+		return sp
+	}
+
+	done := make([]bool, n)
+	h := binaryheap.MinHeap(compareItems, item{src, 0})
+	for !h.Empty() {
+		it, _ := h.Pop()
+		u := it.v
+		if done[u] {
+			continue
+		}
+		done[u] = true
+		sp.order.Push(u)
+		for _, v := range g.Neighbors(u) {
+			alt := sp.dist[u] + weight(g, u, v, true)
+			switch {
+			case alt < sp.dist[v]:
+				sp.dist[v] = alt
+				sp.sigma[v] = sp.sigma[u]
+				sp.preds[v] = []int{u}
+				h.Add(item{v, alt})
+			case alt == sp.dist[v] && !done[v]:
+				sp.sigma[v] += sp.sigma[u]
+				sp.preds[v] = append(sp.preds[v], u)
+			}
+		}
+	}
+	return sp
+}
+
+func Demo() {
+	g := adjacencylist.New(graphs.Options{
+		TotalVertices: 5,
+		Undirected:    true,
+	})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(3, 4, 1)
+
+	fmt.Println("pagerank:   ", PageRank(g, DefaultPageRankOptions()))
+	fmt.Println("betweenness:", Betweenness(g, false))
+	fmt.Println("closeness:  ", Closeness(g, false))
+	fmt.Println("harmonic:   ", Harmonic(g, false))
+	fmt.Println("eigenvector:", Eigenvector(g, false, 1e-9, 1000))
+}
@@ -0,0 +1,148 @@
+package centrality
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+const delta = 1e-6
+
+// 0 - 1 - 2
+var path = []graphtest.Edge{{0, 1, 1}, {1, 2, 1}}
+
+// 0 is the centre of the star
+var star = []graphtest.Edge{{0, 1, 1}, {0, 2, 1}, {0, 3, 1}}
+
+// 0 - 1 - 2 is shorter than 0 - 2
+var weightedTriangle = []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {0, 2, 5}}
+
+func assertScores(t *testing.T, got, want []float64) {
+	t.Helper()
+	helpers.AssertEqual(t, len(got), len(want))
+	for v := range want {
+		helpers.AssertInDelta(t, got[v], want[v], delta)
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		n          int
+		undirected bool
+		edges      []graphtest.Edge
+		options    PageRankOptions
+		want       []float64
+	}{
+		{"directed cycle", 3, false, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}}, DefaultPageRankOptions(), []float64{1.0 / 3, 1.0 / 3, 1.0 / 3}},
+		{"dangling uniform", 2, false, []graphtest.Edge{{0, 1, 1}}, DefaultPageRankOptions(), []float64{0.5 / 1.425, 1 - 0.5/1.425}},
+		{"dangling self", 2, false, []graphtest.Edge{{0, 1, 1}}, PageRankOptions{Damping: 0.85, Dangling: DanglingSelf}, []float64{0.075, 0.925}},
+		{"dangling drop", 2, false, []graphtest.Edge{{0, 1, 1}}, PageRankOptions{Damping: 0.85, Dangling: DanglingDrop}, []float64{0.075, 0.13875}},
+		{"undirected star", 4, true, star, PageRankOptions{Damping: 0.5}, []float64{5.0 / 12, 7.0 / 36, 7.0 / 36, 7.0 / 36}},
+		{"no damping", 4, true, star, PageRankOptions{}, []float64{0.25, 0.25, 0.25, 0.25}},
+	}
+
+	for _, tc := range testCases {
+		for _, g := range graphtest.BuildEach(tc.n, tc.undirected, tc.edges) {
+			t.Run(fmt.Sprintf("%v on %v", tc.desc, g.Name()), func(t *testing.T) {
+				assertScores(t, PageRank(g, tc.options), tc.want)
+			})
+		}
+	}
+}
+
+func TestBetweenness(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		n          int
+		undirected bool
+		edges      []graphtest.Edge
+		weighted   bool
+		want       []float64
+	}{
+		{"undirected path", 3, true, path, false, []float64{0, 1, 0}},
+		{"directed path", 3, false, path, false, []float64{0, 1, 0}},
+		{"undirected star", 4, true, star, false, []float64{3, 0, 0, 0}},
+		{"directed diamond", 4, false, []graphtest.Edge{{0, 1, 1}, {0, 2, 1}, {1, 3, 1}, {2, 3, 1}}, false, []float64{0, 0.5, 0.5, 0}},
+		{"unweighted triangle", 3, true, weightedTriangle, false, []float64{0, 0, 0}},
+		{"weighted triangle", 3, true, weightedTriangle, true, []float64{0, 1, 0}},
+	}
+
+	for _, tc := range testCases {
+		for _, g := range graphtest.BuildEach(tc.n, tc.undirected, tc.edges) {
+			t.Run(fmt.Sprintf("%v on %v", tc.desc, g.Name()), func(t *testing.T) {
+				assertScores(t, Betweenness(g, tc.weighted), tc.want)
+			})
+		}
+	}
+}
+
+func TestCloseness(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		n          int
+		undirected bool
+		edges      []graphtest.Edge
+		weighted   bool
+		want       []float64
+	}{
+		{"undirected path", 3, true, path, false, []float64{2.0 / 3, 1, 2.0 / 3}},
+		{"directed path", 3, false, path, false, []float64{2.0 / 3, 0.5, 0}},
+		{"weighted triangle", 3, true, weightedTriangle, true, []float64{2.0 / 3, 1, 2.0 / 3}},
+	}
+
+	for _, tc := range testCases {
+		for _, g := range graphtest.BuildEach(tc.n, tc.undirected, tc.edges) {
+			t.Run(fmt.Sprintf("%v on %v", tc.desc, g.Name()), func(t *testing.T) {
+				assertScores(t, Closeness(g, tc.weighted), tc.want)
+			})
+		}
+	}
+}
+
+func TestHarmonic(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		n          int
+		undirected bool
+		edges      []graphtest.Edge
+		weighted   bool
+		want       []float64
+	}{
+		{"undirected path", 3, true, path, false, []float64{1.5, 2, 1.5}},
+		{"disconnected", 3, true, []graphtest.Edge{{0, 1, 1}}, false, []float64{1, 1, 0}},
+		{"weighted triangle", 3, true, weightedTriangle, true, []float64{1.5, 2, 1.5}},
+	}
+
+	for _, tc := range testCases {
+		for _, g := range graphtest.BuildEach(tc.n, tc.undirected, tc.edges) {
+			t.Run(fmt.Sprintf("%v on %v", tc.desc, g.Name()), func(t *testing.T) {
+				assertScores(t, Harmonic(g, tc.weighted), tc.want)
+			})
+		}
+	}
+}
+
+func TestEigenvector(t *testing.T) {
+	third := 1 / math.Sqrt(3)
+	testCases := []struct {
+		desc  string
+		n     int
+		edges []graphtest.Edge
+		want  []float64
+	}{
+		{"triangle", 3, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {0, 2, 1}}, []float64{third, third, third}},
+		{"star", 4, star, []float64{1 / math.Sqrt(2), 1 / math.Sqrt(6), 1 / math.Sqrt(6), 1 / math.Sqrt(6)}},
+	}
+
+	for _, tc := range testCases {
+		for _, g := range graphtest.BuildEach(tc.n, true, tc.edges) {
+			t.Run(fmt.Sprintf("%v on %v", tc.desc, g.Name()), func(t *testing.T) {
+				assertScores(t, Eigenvector(g, false, 1e-12, 10000), tc.want)
+			})
+		}
+	}
+}
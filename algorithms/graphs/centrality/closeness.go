@@ -0,0 +1,55 @@
+package centrality
+
+import (
+	"math"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Closeness computes the closeness centrality of every vertex: the inverse of
+// its average distance to the vertices it can reach. Distances are measured
+// along outgoing edges.
+//
+// To keep scores comparable in disconnected graphs, the score is scaled by the
+// fraction of the other vertices that are reachable (Wasserman and Faust).
+func Closeness[W graphs.Number](g graphs.WeightedGraph[W], weighted bool) []float64 {
+	n := g.Size()
+	cc := make([]float64, n)
+	if n < 2 {
+		return cc
+	}
+
+	for v := 0; v < n; v++ {
+		sp := search(g, v, weighted)
+		total, reachable := 0.0, 0
+		for u, d := range sp.dist {
+			if u != v && !math.IsInf(d, 1) {
+				total += d
+				reachable++
+			}
+		}
+		if total > 0 {
+			r := float64(reachable)
+			cc[v] = (r / total) * (r / float64(n-1))
+		}
+	}
+	return cc
+}
+
+// Harmonic computes the harmonic centrality of every vertex: the sum of the
+// inverse distances to every other vertex, where unreachable vertices
+// contribute 0.
+func Harmonic[W graphs.Number](g graphs.WeightedGraph[W], weighted bool) []float64 {
+	n := g.Size()
+	hc := make([]float64, n)
+
+	for v := 0; v < n; v++ {
+		sp := search(g, v, weighted)
+		for u, d := range sp.dist {
+			if u != v && d > 0 && !math.IsInf(d, 1) {
+				hc[v] += 1 / d
+			}
+		}
+	}
+	return hc
+}
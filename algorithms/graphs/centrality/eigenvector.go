@@ -0,0 +1,56 @@
+package centrality
+
+import (
+	"math"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Eigenvector computes the eigenvector centrality of every vertex: a vertex is
+// important when the vertices pointing to it are important. Scores are the
+// principal eigenvector of the transposed adjacency matrix, normalised to unit
+// length.
+//
+// Power iteration is run on A + I rather than A, which has the same
+// eigenvectors but converges on bipartite graphs too. Iteration stops once the
+// L1 change drops below n * tolerance, or after maxIterations.
+func Eigenvector[W graphs.Number](g graphs.WeightedGraph[W], weighted bool, tolerance float64, maxIterations int) []float64 {
+	n := g.Size()
+	x := make([]float64, n)
+	if n == 0 {
+		return x
+	}
+	for v := range x {
+		x[v] = 1 / float64(n)
+	}
+
+	for i := 0; i < maxIterations; i++ {
+		next := make([]float64, n)
+		copy(next, x)
+		for u := 0; u < n; u++ {
+			for _, v := range g.Neighbors(u) {
+				next[v] += x[u] * weight(g, u, v, weighted)
+			}
+		}
+
+		norm := 0.0
+		for _, s := range next {
+			norm += s * s
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			return next
+		}
+
+		change := 0.0
+		for v := range next {
+			next[v] /= norm
+			change += math.Abs(next[v] - x[v])
+		}
+		x = next
+		if change < float64(n)*tolerance {
+			break
+		}
+	}
+	return x
+}
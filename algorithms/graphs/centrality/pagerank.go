@@ -0,0 +1,100 @@
+package centrality
+
+import (
+	"math"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Dangling decides what happens to the rank held by vertices without any
+// outgoing edges.
+type Dangling int
+
+const (
+	// The rank of dangling vertices is spread evenly across every vertex.
+	DanglingUniform = Dangling(iota)
+	// Dangling vertices keep their rank, as if they had a self-loop.
+	DanglingSelf
+	// The rank of dangling vertices leaks out of the graph and is lost.
+	DanglingDrop
+)
+
+type PageRankOptions struct {
+	// Damping is the probability of following an edge. It is used as given,
+	// so 0 makes every vertex equally likely; DefaultPageRankOptions sets the
+	// usual 0.85.
+	Damping       float64
+	Tolerance     float64 // L1 change at which iteration stops, defaults to 1e-9
+	MaxIterations int     // defaults to 100
+	Dangling      Dangling
+}
+
+// DefaultPageRankOptions returns the options PageRank is usually run with.
+func DefaultPageRankOptions() PageRankOptions {
+	return PageRankOptions{Damping: defaultDamping, Tolerance: defaultTolerance, MaxIterations: defaultMaxIterations}
+}
+
+const (
+	defaultDamping       = 0.85
+	defaultTolerance     = 1e-9
+	defaultMaxIterations = 100
+)
+
+// PageRank computes the stationary distribution of a random surfer who follows
+// an outgoing edge with probability o.Damping and otherwise jumps to a
+// uniformly random vertex. Every outgoing edge is equally likely.
+func PageRank(g graphs.Topology, o PageRankOptions) []float64 {
+	if o.Tolerance == 0 {
+		o.Tolerance = defaultTolerance
+	}
+	if o.MaxIterations == 0 {
+		o.MaxIterations = defaultMaxIterations
+	}
+
+	n := g.Size()
+	if n == 0 {
+		return []float64{}
+	}
+
+	neighbors := make([][]int, n)
+	for v := 0; v < n; v++ {
+		neighbors[v] = g.Neighbors(v)
+	}
+
+	rank := make([]float64, n)
+	for v := range rank {
+		rank[v] = 1 / float64(n)
+	}
+
+	for i := 0; i < o.MaxIterations; i++ {
+		next := make([]float64, n)
+		dangling := 0.0
+		for u := 0; u < n; u++ {
+			if len(neighbors[u]) == 0 {
+				switch o.Dangling {
+				case DanglingUniform:
+					dangling += rank[u]
+				case DanglingSelf:
+					next[u] += o.Damping * rank[u]
+				}
+				continue
+			}
+			share := o.Damping * rank[u] / float64(len(neighbors[u]))
+			for _, v := range neighbors[u] {
+				next[v] += share
+			}
+		}
+
+		teleport := (1-o.Damping)/float64(n) + o.Damping*dangling/float64(n)
+		change := 0.0
+		for v := range next {
+			next[v] += teleport
+			change += math.Abs(next[v] - rank[v])
+		}
+		rank = next
+		if change < o.Tolerance {
+			break
+		}
+	}
+	return rank
+}
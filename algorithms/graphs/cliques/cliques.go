@@ -0,0 +1,212 @@
+package cliques
+
+import (
+	"fmt"
+	"iter"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+	"github.com/mhrdini/godsa/datastructures/utils/sorter"
+)
+
+// A clique is a set of vertices that are all adjacent to each other. It is
+// maximal if no other vertex can be added to it, and maximum if no clique is
+// larger. Edge directions are ignored and self-loops are skipped.
+
+type set map[int]bool
+
+// adjacency returns the undirected neighbourhood of every vertex of g.
+func adjacency(g datastructures.Topology) []set {
+	adj := make([]set, g.Size())
+	for u := range adj {
+		adj[u] = set{}
+	}
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			if u != v {
+				adj[u][v] = true
+				adj[v][u] = true
+			}
+		}
+	}
+	return adj
+}
+
+// Degeneracy returns the vertices of g in a degeneracy ordering, obtained by
+// repeatedly removing a vertex of smallest remaining degree, along with the
+// degeneracy: the largest degree seen at removal. Every vertex has at most
+// that many neighbours later in the ordering.
+func Degeneracy(g datastructures.Topology) ([]int, int) {
+	return degeneracy(adjacency(g))
+}
+
+func degeneracy(adj []set) ([]int, int) {
+	n := len(adj)
+	degree := make([]int, n)
+	buckets := make([]set, n+1) // vertices by remaining degree
+	for d := range buckets {
+		buckets[d] = set{}
+	}
+	for v := range adj {
+		degree[v] = len(adj[v])
+		buckets[degree[v]][v] = true
+	}
+
+	removed := make([]bool, n)
+	order := make([]int, 0, n)
+	k, d := 0, 0
+	for len(order) < n {
+		// removing a vertex lowers degrees by at most one
+		if d > 0 {
+			d--
+		}
+		for len(buckets[d]) == 0 {
+			d++
+		}
+		v := smallest(buckets[d])
+		delete(buckets[d], v)
+		removed[v] = true
+		order = append(order, v)
+		k = max(k, d)
+		for u := range adj[v] {
+			if !removed[u] {
+				delete(buckets[degree[u]], u)
+				degree[u]--
+				buckets[degree[u]][u] = true
+			}
+		}
+	}
+	return order, k
+}
+
+// smallest returns the smallest vertex in s, so that ties are broken the same
+// way every time.
+func smallest(s set) int {
+	v := -1
+	for u := range s {
+		if v == -1 || u < v {
+			v = u
+		}
+	}
+	return v
+}
+
+func sorted(s set) []int {
+	vs := make([]int, 0, len(s))
+	for v := range s {
+		vs = append(vs, v)
+	}
+	sorter.Sort(vs, comparator.OrderedComparator[int])
+	return vs
+}
+
+// Maximal yields every maximal clique of g once, with its vertices sorted,
+// using Bron-Kerbosch with pivoting. The outer level follows a degeneracy
+// ordering, so that each branch only considers the later neighbours of its
+// vertex, which keeps the search fast on sparse graphs.
+func Maximal(g datastructures.Topology) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		adj := adjacency(g)
+		order, _ := degeneracy(adj)
+		position := make([]int, len(order))
+		for i, v := range order {
+			position[v] = i
+		}
+
+		for _, v := range order {
+			p, x := set{}, set{}
+			for u := range adj[v] {
+				if position[u] > position[v] {
+					p[u] = true
+				} else {
+					x[u] = true
+				}
+			}
+			if !bronKerbosch(adj, set{v: true}, p, x, yield) {
+				return
+			}
+		}
+	}
+}
+
+// bronKerbosch extends the clique r with vertices from p, where x holds the
+// vertices that have already been tried. It returns false once the caller has
+// stopped.
+func bronKerbosch(adj []set, r, p, x set, yield func([]int) bool) bool {
+	if len(p) == 0 {
+		if len(x) == 0 {
+			return yield(sorted(r))
+		}
+		return true
+	}
+
+	// branching on a pivot's neighbours would only find subsets of cliques
+	// containing the pivot, so pick the pivot that rules out the most
+	pivot, best := -1, -1
+	for _, u := range append(sorted(p), sorted(x)...) {
+		count := 0
+		for v := range p {
+			if adj[u][v] {
+				count++
+			}
+		}
+		if count > best {
+			pivot, best = u, count
+		}
+	}
+
+	for _, v := range sorted(p) {
+		if adj[pivot][v] {
+			continue
+		}
+		r[v] = true
+		np, nx := set{}, set{}
+		for u := range adj[v] {
+			if p[u] {
+				np[u] = true
+			}
+			if x[u] {
+				nx[u] = true
+			}
+		}
+		ok := bronKerbosch(adj, r, np, nx, yield)
+		delete(r, v)
+		if !ok {
+			return false
+		}
+		delete(p, v)
+		x[v] = true
+	}
+	return true
+}
+
+// Maximum returns a largest clique of g, with its vertices sorted.
+func Maximum(g datastructures.Topology) []int {
+	best := []int{}
+	for c := range Maximal(g) {
+		if len(c) > len(best) {
+			best = c
+		}
+	}
+	return best
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 6,
+		Undirected:    true,
+	})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 4, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 4, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(3, 5, 1)
+
+	for c := range Maximal(g) {
+		fmt.Println(c)
+	}
+	fmt.Println("maximum:", Maximum(g))
+}
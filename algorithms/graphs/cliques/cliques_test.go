@@ -0,0 +1,119 @@
+package cliques
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/datastructures/utils/sorter"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func collect(g datastructures.Graph) []string {
+	cs := []string{}
+	for c := range Maximal(g) {
+		cs = append(cs, fmt.Sprint(c))
+	}
+	sorter.Sort(cs, func(a, b string) int {
+		switch {
+		case a < b:
+			return -1
+		case a == b:
+			return 0
+		default:
+			return 1
+		}
+	})
+	return cs
+}
+
+// the graph from Wikipedia's page on the Bron-Kerbosch algorithm, 0-indexed
+var wikipedia = [][2]int{{0, 1}, {0, 4}, {1, 2}, {1, 4}, {2, 3}, {3, 4}, {3, 5}}
+
+func TestMaximal(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		n          int
+		undirected bool
+		edges      [][2]int
+		want       string
+	}{
+		{"wikipedia", 6, true, wikipedia, "[[0 1 4] [1 2] [2 3] [3 4] [3 5]]"},
+		{"directed edges are ignored", 6, false, wikipedia, "[[0 1 4] [1 2] [2 3] [3 4] [3 5]]"},
+		{"isolated vertices", 3, true, [][2]int{{0, 0}}, "[[0] [1] [2]]"},
+		{"complete", 4, true, [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}, "[[0 1 2 3]]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			helpers.AssertEqual(t, fmt.Sprint(collect(graphtest.Build(tc.n, tc.undirected, graphtest.Unweighted(tc.edges)))), tc.want)
+		})
+	}
+}
+
+func TestMaximum(t *testing.T) {
+	helpers.AssertEqual(t, helpers.ToString(Maximum(graphtest.Build(6, true, graphtest.Unweighted(wikipedia)))), "[0 1 4]")
+
+	t.Run("random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 50; trial++ {
+			n := 1 + rng.Intn(12)
+			g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n), Undirected: true})
+			for i := 0; i < rng.Intn(n*n/2+1); i++ {
+				g.AddEdge(rng.Intn(n), rng.Intn(n), 1)
+			}
+
+			// brute force over every subset
+			want := 0
+			for mask := 1; mask < 1<<n; mask++ {
+				clique, size := true, 0
+				for u := 0; u < n && clique; u++ {
+					if mask>>u&1 == 0 {
+						continue
+					}
+					size++
+					for v := u + 1; v < n; v++ {
+						if mask>>v&1 == 1 && !g.Adjacent(u, v) {
+							clique = false
+							break
+						}
+					}
+				}
+				if clique {
+					want = max(want, size)
+				}
+			}
+			helpers.AssertEqual(t, len(Maximum(g)), want)
+
+			// every maximal clique is reported once
+			seen := map[string]bool{}
+			for _, c := range collect(g) {
+				helpers.Assert(t, !seen[c])
+				seen[c] = true
+			}
+		}
+	})
+}
+
+func TestDegeneracy(t *testing.T) {
+	order, k := Degeneracy(graphtest.Build(6, true, graphtest.Unweighted(wikipedia)))
+	helpers.AssertEqual(t, len(order), 6)
+	helpers.AssertEqual(t, k, 2)
+
+	_, k = Degeneracy(graphtest.Build(4, true, graphtest.Unweighted([][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}})))
+	helpers.AssertEqual(t, k, 3)
+}
+
+func TestMaximalStopsEarly(t *testing.T) {
+	count := 0
+	for range Maximal(graphtest.Build(6, true, graphtest.Unweighted(wikipedia))) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	helpers.AssertEqual(t, count, 2)
+}
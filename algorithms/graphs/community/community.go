@@ -0,0 +1,150 @@
+package community
+
+import (
+	"fmt"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+	"github.com/mhrdini/godsa/datastructures/utils/sorter"
+)
+
+// Community detection partitions the vertices of a graph into groups that are
+// densely connected internally and sparsely connected to each other.
+//
+// Edge directions are ignored: in a directed graph the weights of (u, v) and
+// (v, u) are added together. Edge weights must be positive.
+
+type Result struct {
+	Communities []int // community of each vertex, numbered from 0 in order of first appearance
+	Modularity  float64
+}
+
+// network is an undirected weighted graph with sorted neighbour lists, so that
+// iterating over it is deterministic.
+type network struct {
+	nbrs    [][]int
+	weights [][]float64
+	loops   []float64 // weight of the self-loop on each node, counted once
+	degree  []float64 // weighted degree of each node, self-loops counted twice
+	total   float64   // sum of all degrees, i.e. twice the total edge weight
+}
+
+func newNetwork[W graphs.Number](g graphs.WeightedGraph[W]) *network {
+	n := g.Size()
+	adj := make([]map[int]float64, n)
+	for u := range adj {
+		adj[u] = map[int]float64{}
+	}
+	loops := make([]float64, n)
+	for u := 0; u < n; u++ {
+		for _, v := range g.Neighbors(u) {
+			w, _ := g.Weight(u, v)
+			switch {
+			case u == v:
+				loops[u] += float64(w)
+			case g.Undirected():
+				adj[u][v] = float64(w)
+			default:
+				adj[u][v] += float64(w)
+				adj[v][u] += float64(w)
+			}
+		}
+	}
+	return fromMaps(adj, loops)
+}
+
+func fromMaps(adj []map[int]float64, loops []float64) *network {
+	n := len(adj)
+	net := &network{
+		nbrs:    make([][]int, n),
+		weights: make([][]float64, n),
+		loops:   loops,
+		degree:  make([]float64, n),
+	}
+	for u := 0; u < n; u++ {
+		nbrs := make([]int, 0, len(adj[u]))
+		for v := range adj[u] {
+			nbrs = append(nbrs, v)
+		}
+		sorter.Sort(nbrs, comparator.OrderedComparator[int])
+		net.nbrs[u] = nbrs
+		net.weights[u] = make([]float64, len(nbrs))
+		net.degree[u] = 2 * loops[u]
+		for i, v := range nbrs {
+			net.weights[u][i] = adj[u][v]
+			net.degree[u] += adj[u][v]
+		}
+		net.total += net.degree[u]
+	}
+	return net
+}
+
+func (net *network) size() int {
+	return len(net.nbrs)
+}
+
+// modularity computes Q = sum over communities c of L_c/m - (d_c/2m)^2, where
+// L_c is the weight of edges inside c, d_c the total degree of c and m the
+// total edge weight.
+func (net *network) modularity(communities []int) float64 {
+	if net.total == 0 {
+		return 0
+	}
+	internal := map[int]float64{}
+	degree := map[int]float64{}
+	for u := 0; u < net.size(); u++ {
+		c := communities[u]
+		degree[c] += net.degree[u]
+		internal[c] += net.loops[u]
+		for i, v := range net.nbrs[u] {
+			if communities[v] == c {
+				internal[c] += net.weights[u][i] / 2
+			}
+		}
+	}
+	m := net.total / 2
+	q := 0.0
+	for c, d := range degree {
+		q += internal[c]/m - (d/net.total)*(d/net.total)
+	}
+	return q
+}
+
+// Modularity evaluates how well communities partitions g: the fraction of edge
+// weight falling inside communities minus the fraction expected if edges were
+// placed at random with the same degrees. It lies in [-1/2, 1).
+func Modularity[W graphs.Number](g graphs.WeightedGraph[W], communities []int) float64 {
+	return newNetwork(g).modularity(communities)
+}
+
+// renumber relabels communities from 0 in order of first appearance.
+func renumber(communities []int) []int {
+	ids := map[int]int{}
+	result := make([]int, len(communities))
+	for v, c := range communities {
+		if _, ok := ids[c]; !ok {
+			ids[c] = len(ids)
+		}
+		result[v] = ids[c]
+	}
+	return result
+}
+
+func Demo() {
+	g := adjacencylist.New(graphs.Options{
+		TotalVertices: 6,
+		Undirected:    true,
+	})
+	// two triangles joined by a bridge
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(3, 5, 1)
+	g.AddEdge(2, 3, 1)
+
+	fmt.Println("label propagation:", LabelPropagation(g, 1))
+	fmt.Println("louvain:          ", Louvain(g))
+}
@@ -0,0 +1,105 @@
+package community
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+const delta = 1e-9
+
+// two triangles {0, 1, 2} and {3, 4, 5} joined by the edge 2 - 3
+var bridged = []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {0, 2, 1}, {3, 4, 1}, {4, 5, 1}, {3, 5, 1}, {2, 3, 1}}
+
+// two disjoint 4-cliques {0, 1, 2, 3} and {4, 5, 6, 7}
+var cliques = []graphtest.Edge{
+	{0, 1, 1}, {0, 2, 1}, {0, 3, 1}, {1, 2, 1}, {1, 3, 1}, {2, 3, 1},
+	{4, 5, 1}, {4, 6, 1}, {4, 7, 1}, {5, 6, 1}, {5, 7, 1}, {6, 7, 1},
+}
+
+func TestModularity(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		n           int
+		undirected  bool
+		edges       []graphtest.Edge
+		communities []int
+		want        float64
+	}{
+		{"single community", 6, true, bridged, []int{0, 0, 0, 0, 0, 0}, 0},
+		{"two triangles", 6, true, bridged, []int{0, 0, 0, 1, 1, 1}, 6.0/7 - 0.5},
+		{"singletons", 3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}}, []int{0, 1, 2}, -(1.0/16 + 1.0/4 + 1.0/16)},
+		{"directed edges are symmetrised", 6, false, bridged, []int{0, 0, 0, 1, 1, 1}, 6.0/7 - 0.5},
+		{"edge weights", 4, true, []graphtest.Edge{{0, 1, 3}, {2, 3, 3}, {1, 2, 1}}, []int{0, 0, 1, 1}, 6.0/7 - 0.5},
+		{"no edges", 2, true, []graphtest.Edge{}, []int{0, 1}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			helpers.AssertInDelta(t, Modularity(graphtest.Build(tc.n, tc.undirected, tc.edges), tc.communities), tc.want, delta)
+		})
+	}
+}
+
+func TestLouvain(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		n     int
+		edges []graphtest.Edge
+		want  []int
+	}{
+		{"bridged triangles", 6, bridged, []int{0, 0, 0, 1, 1, 1}},
+		{"disjoint cliques", 8, cliques, []int{0, 0, 0, 0, 1, 1, 1, 1}},
+		{"isolated vertices", 3, []graphtest.Edge{{0, 1, 1}}, []int{0, 0, 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := graphtest.Build(tc.n, true, tc.edges)
+			got := Louvain(g)
+			helpers.AssertEqual(t, helpers.ToString(got.Communities), helpers.ToString(tc.want))
+			helpers.AssertInDelta(t, got.Modularity, Modularity(g, tc.want), delta)
+		})
+	}
+
+	t.Run("ring of cliques merges in a second pass", func(t *testing.T) {
+		// six triangles in a ring, each joined to the next by one edge
+		g := adjacencylist.New(graphs.Options{TotalVertices: 18, Undirected: true})
+		for c := 0; c < 6; c++ {
+			a, b, d := 3*c, 3*c+1, 3*c+2
+			g.AddEdge(a, b, 1)
+			g.AddEdge(b, d, 1)
+			g.AddEdge(a, d, 1)
+			g.AddEdge(d, (3*c+3)%18, 1)
+		}
+		got := Louvain(g)
+		for v := 0; v < 18; v++ {
+			helpers.AssertEqual(t, got.Communities[v], got.Communities[v-v%3])
+		}
+		helpers.Assert(t, got.Modularity >= Modularity(g, []int{0, 0, 0, 1, 1, 1, 2, 2, 2, 3, 3, 3, 4, 4, 4, 5, 5, 5})-delta)
+	})
+}
+
+func TestLabelPropagation(t *testing.T) {
+	g := graphtest.Build(8, true, cliques)
+	for seed := int64(0); seed < 5; seed++ {
+		t.Run(fmt.Sprintf("seed %v", seed), func(t *testing.T) {
+			got := LabelPropagation(g, seed)
+			helpers.AssertEqual(t, helpers.ToString(got.Communities), "[0 0 0 0 1 1 1 1]")
+			helpers.AssertInDelta(t, got.Modularity, 0.5, delta)
+		})
+	}
+
+	t.Run("deterministic for a seed", func(t *testing.T) {
+		g := graphtest.Build(6, true, bridged)
+		want := LabelPropagation(g, 42)
+		for i := 0; i < 10; i++ {
+			got := LabelPropagation(g, 42)
+			helpers.AssertEqual(t, helpers.ToString(got), helpers.ToString(want))
+		}
+	})
+}
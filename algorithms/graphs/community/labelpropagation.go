@@ -0,0 +1,76 @@
+package community
+
+import (
+	"math/rand"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+const maxLabelPropagationRounds = 100
+
+// LabelPropagation detects communities by letting every vertex repeatedly adopt
+// the label carrying the most edge weight among its neighbours, until no label
+// changes. Vertices are visited in a random order each round and ties are
+// broken randomly, both driven by seed, so the same seed always yields the
+// same partition.
+//
+// A vertex keeps its current label whenever it is one of the best, which
+// guarantees termination.
+func LabelPropagation[W graphs.Number](g graphs.WeightedGraph[W], seed int64) Result {
+	net := newNetwork(g)
+	n := net.size()
+	rng := rand.New(rand.NewSource(seed))
+
+	labels := make([]int, n)
+	order := make([]int, n)
+	for v := range labels {
+		labels[v] = v
+		order[v] = v
+	}
+
+	for round := 0; round < maxLabelPropagationRounds; round++ {
+		rng.Shuffle(n, func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+
+		changed := false
+		for _, u := range order {
+			if len(net.nbrs[u]) == 0 {
+				continue
+			}
+			weights := map[int]float64{}
+			candidates := []int{} // labels in order of first appearance
+			for i, v := range net.nbrs[u] {
+				l := labels[v]
+				if _, ok := weights[l]; !ok {
+					candidates = append(candidates, l)
+				}
+				weights[l] += net.weights[u][i]
+			}
+
+			best := 0.0
+			for _, l := range candidates {
+				if weights[l] > best {
+					best = weights[l]
+				}
+			}
+			if weights[labels[u]] == best {
+				continue
+			}
+			ties := []int{}
+			for _, l := range candidates {
+				if weights[l] == best {
+					ties = append(ties, l)
+				}
+			}
+			labels[u] = ties[rng.Intn(len(ties))]
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+
+	communities := renumber(labels)
+	return Result{communities, net.modularity(communities)}
+}
@@ -0,0 +1,118 @@
+package community
+
+import (
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// minGain is the smallest modularity gain that counts as an improvement, so
+// that floating point noise cannot make nodes move back and forth forever.
+const minGain = 1e-12
+
+// Louvain detects communities by greedily optimising modularity (Blondel et
+// al.). Each pass moves single nodes to the neighbouring community with the
+// largest modularity gain until no move helps, then collapses every community
+// into a single node and repeats on the smaller network. Nodes are visited in
+// vertex order, so the result is deterministic.
+func Louvain[W graphs.Number](g graphs.WeightedGraph[W]) Result {
+	net := newNetwork(g)
+
+	// node in the current network that each vertex belongs to
+	membership := make([]int, net.size())
+	for v := range membership {
+		membership[v] = v
+	}
+
+	for {
+		communities, moved := net.moveNodes()
+		if !moved {
+			break
+		}
+		communities = renumber(communities)
+		for v, node := range membership {
+			membership[v] = communities[node]
+		}
+		net = net.aggregate(communities)
+	}
+
+	communities := renumber(membership)
+	return Result{communities, Modularity(g, communities)}
+}
+
+// moveNodes runs the local moving phase and reports whether any node changed
+// community.
+func (net *network) moveNodes() ([]int, bool) {
+	n := net.size()
+	communities := make([]int, n)
+	totals := make([]float64, n) // total degree of each community
+	for u := 0; u < n; u++ {
+		communities[u] = u
+		totals[u] = net.degree[u]
+	}
+	if net.total == 0 {
+		return communities, false
+	}
+
+	moved := false
+	for improved := true; improved; {
+		improved = false
+		for u := 0; u < n; u++ {
+			current := communities[u]
+			k := net.degree[u]
+
+			// weight from u into each neighbouring community
+			links := map[int]float64{}
+			candidates := []int{}
+			for i, v := range net.nbrs[u] {
+				c := communities[v]
+				if _, ok := links[c]; !ok {
+					candidates = append(candidates, c)
+				}
+				links[c] += net.weights[u][i]
+			}
+
+			totals[current] -= k
+			best, bestGain := current, links[current]-totals[current]*k/net.total
+			for _, c := range candidates {
+				if gain := links[c] - totals[c]*k/net.total; gain > bestGain+minGain {
+					best, bestGain = c, gain
+				}
+			}
+			totals[best] += k
+
+			if best != current {
+				communities[u] = best
+				improved = true
+				moved = true
+			}
+		}
+	}
+	return communities, moved
+}
+
+// aggregate builds the network whose nodes are the communities of net.
+// Communities must be numbered from 0.
+func (net *network) aggregate(communities []int) *network {
+	k := 0
+	for _, c := range communities {
+		if c+1 > k {
+			k = c + 1
+		}
+	}
+	adj := make([]map[int]float64, k)
+	for c := range adj {
+		adj[c] = map[int]float64{}
+	}
+	loops := make([]float64, k)
+	for u := 0; u < net.size(); u++ {
+		cu := communities[u]
+		loops[cu] += net.loops[u]
+		for i, v := range net.nbrs[u] {
+			if cv := communities[v]; cu == cv {
+				loops[cu] += net.weights[u][i] / 2
+			} else {
+				adj[cu][cv] += net.weights[u][i]
+			}
+		}
+	}
+	return fromMaps(adj, loops)
+}
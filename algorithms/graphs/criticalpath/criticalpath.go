@@ -0,0 +1,228 @@
+package criticalpath
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/toposort"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+// A project is a DAG in which an edge (u, v) means v cannot start before u has
+// finished. Durations are either given per vertex, where every vertex is a
+// task, or taken from the edge weights, where every edge is an activity and
+// the vertices are the events that start and end them. Both are handled by
+// giving every vertex a duration and every edge a lag: the lag is 0 in the
+// first case and the vertex durations are 0 in the second.
+
+// Task is the timing of a single task or activity. Starting it anywhere
+// between its earliest and latest start does not delay the project.
+type Task[W datastructures.Number] struct {
+	Duration       W
+	EarliestStart  W
+	EarliestFinish W
+	LatestStart    W
+	LatestFinish   W
+}
+
+// Slack returns how long the task can be delayed without delaying the project.
+func (t Task[W]) Slack() W {
+	return t.LatestStart - t.EarliestStart
+}
+
+// Critical reports whether delaying the task delays the project.
+func (t Task[W]) Critical() bool {
+	return t.Slack() == 0
+}
+
+func (t Task[W]) String() string {
+	return fmt.Sprintf("[%v, %v] -> [%v, %v] (slack %v)", t.EarliestStart, t.EarliestFinish, t.LatestStart, t.LatestFinish, t.Slack())
+}
+
+// CycleError is returned for a graph that is not a DAG.
+type CycleError struct {
+	// Cycle lists its vertices in order, each with an edge to the next and
+	// the last with an edge back to the first.
+	Cycle []int
+}
+
+func (e *CycleError) Error() string {
+	b := strings.Builder{}
+	for _, v := range e.Cycle {
+		fmt.Fprintf(&b, "%v -> ", v)
+	}
+	fmt.Fprintf(&b, "%v", e.Cycle[0])
+	return fmt.Sprintf("error: graph has a cycle %v", b.String())
+}
+
+type Schedule[W datastructures.Number] struct {
+	// Tasks holds the timing of every vertex. When durations come from the
+	// edges the vertices are events, so their duration is 0 and they start
+	// and finish at the same time.
+	Tasks  []Task[W]
+	Length W
+
+	g     datastructures.Topology
+	order []int
+	lag   func(u, v int) W
+}
+
+// FromVertices schedules the tasks of g, the duration of vertex v being
+// durations[v]. Edges only order the tasks; their weights are ignored.
+func FromVertices[W datastructures.Number](g datastructures.Topology, durations []W) (*Schedule[W], error) {
+	if len(durations) != g.Size() {
+		return nil, fmt.Errorf("error: %v durations given for %v vertices", len(durations), g.Size())
+	}
+	return schedule(g, durations, func(u, v int) W { return 0 })
+}
+
+// FromEdges schedules the activities of g, the duration of edge (u, v) being
+// its weight. Use Activity to read the timing of an edge.
+func FromEdges[W datastructures.Number](g datastructures.WeightedGraph[W]) (*Schedule[W], error) {
+	return schedule(g, make([]W, g.Size()), func(u, v int) W {
+		w, _ := g.Weight(u, v)
+		return w
+	})
+}
+
+func schedule[W datastructures.Number](g datastructures.Topology, durations []W, lag func(u, v int) W) (*Schedule[W], error) {
+	if g.Undirected() {
+		return nil, fmt.Errorf("error: graph is undirected")
+	}
+	order, cycle := toposort.Sort(g)
+	if cycle != nil {
+		return nil, &CycleError{cycle}
+	}
+
+	s := &Schedule[W]{
+		Tasks: make([]Task[W], g.Size()),
+		g:     g,
+		order: order,
+		lag:   lag,
+	}
+
+	// forward pass: a task starts once every predecessor has finished
+	for v := range s.Tasks {
+		s.Tasks[v].Duration = durations[v]
+	}
+	for _, u := range order {
+		t := &s.Tasks[u]
+		t.EarliestFinish = t.EarliestStart + t.Duration
+		s.Length = max(s.Length, t.EarliestFinish)
+		for _, v := range g.Neighbors(u) {
+			s.Tasks[v].EarliestStart = max(s.Tasks[v].EarliestStart, t.EarliestFinish+lag(u, v))
+		}
+	}
+
+	// backward pass: a task finishes before any successor has to start
+	for i := len(order) - 1; i >= 0; i-- {
+		u := order[i]
+		t := &s.Tasks[u]
+		t.LatestFinish = s.Length
+		for _, v := range g.Neighbors(u) {
+			t.LatestFinish = min(t.LatestFinish, s.Tasks[v].LatestStart-lag(u, v))
+		}
+		t.LatestStart = t.LatestFinish - t.Duration
+	}
+	return s, nil
+}
+
+// Activity returns the timing of the edge (u, v), which runs between the
+// finish of u and the start of v.
+func (s *Schedule[W]) Activity(u, v int) (Task[W], bool) {
+	if !s.g.Adjacent(u, v) {
+		return Task[W]{}, false
+	}
+	lag := s.lag(u, v)
+	t := Task[W]{
+		Duration:      lag,
+		EarliestStart: s.Tasks[u].EarliestFinish,
+		LatestFinish:  s.Tasks[v].LatestStart,
+	}
+	t.EarliestFinish = t.EarliestStart + lag
+	t.LatestStart = t.LatestFinish - lag
+	return t, true
+}
+
+// Critical returns the critical tasks in topological order.
+func (s *Schedule[W]) Critical() []int {
+	critical := []int{}
+	for _, v := range s.order {
+		if s.Tasks[v].Critical() {
+			critical = append(critical, v)
+		}
+	}
+	return critical
+}
+
+// CriticalPaths yields every path of critical tasks joined by critical edges
+// that starts at time 0 and finishes at the end of the project; these are the
+// longest paths through the project. There can be exponentially many, so
+// callers should stop once they have enough.
+func (s *Schedule[W]) CriticalPaths() iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		path := []int{}
+		inDegrees := make([]int, s.g.Size())
+		for u := range inDegrees {
+			for _, v := range s.g.Neighbors(u) {
+				inDegrees[v]++
+			}
+		}
+
+		// visit returns false once the caller has stopped
+		var visit func(u int) bool
+		visit = func(u int) bool {
+			path = append(path, u)
+			defer func() { path = path[:len(path)-1] }()
+			if s.Tasks[u].EarliestFinish == s.Length && len(s.g.Neighbors(u)) == 0 {
+				return yield(append([]int{}, path...))
+			}
+			for _, v := range s.g.Neighbors(u) {
+				if a, _ := s.Activity(u, v); a.Critical() && s.Tasks[v].Critical() {
+					if !visit(v) {
+						return false
+					}
+				}
+			}
+			return true
+		}
+
+		for _, v := range s.order {
+			if t := s.Tasks[v]; t.Critical() && t.EarliestStart == 0 && inDegrees[v] == 0 {
+				if !visit(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func Demo() {
+	// activity-on-arrow: events 0..5, activities weighted by duration
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 6,
+		Undirected:    false,
+	})
+	g.AddEdge(0, 1, 3)
+	g.AddEdge(0, 2, 2)
+	g.AddEdge(1, 3, 4)
+	g.AddEdge(2, 3, 2)
+	g.AddEdge(2, 4, 3)
+	g.AddEdge(3, 5, 2)
+	g.AddEdge(4, 5, 1)
+
+	s, err := FromEdges(g)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Length:", s.Length)
+	for v, t := range s.Tasks {
+		fmt.Println(v, t)
+	}
+	for p := range s.CriticalPaths() {
+		fmt.Println("Critical path:", p)
+	}
+}
@@ -0,0 +1,126 @@
+package criticalpath
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func collect[W datastructures.Number](s *Schedule[W]) [][]int {
+	paths := [][]int{}
+	for p := range s.CriticalPaths() {
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+func TestFromVertices(t *testing.T) {
+	// 0 (3) -> 1 (2) -> 3 (4)
+	// 0 (3) -> 2 (6) -> 3 (4)
+	//          4 (1) -> 3 (4)
+	durations := []int{3, 2, 6, 4, 1}
+	o := datastructures.Options{TotalVertices: 5}
+	for _, g := range []datastructures.Graph{adjacencylist.New(o), adjacencymatrix.New(o)} {
+		g.AddEdge(0, 1, 1)
+		g.AddEdge(0, 2, 1)
+		g.AddEdge(1, 3, 1)
+		g.AddEdge(2, 3, 1)
+		g.AddEdge(4, 3, 1)
+
+		t.Run(g.Name(), func(t *testing.T) {
+			s, err := FromVertices(g, durations)
+			helpers.AssertEqual(t, err, nil)
+			helpers.AssertEqual(t, s.Length, 13)
+
+			want := []Task[int]{
+				{3, 0, 3, 0, 3},
+				{2, 3, 5, 7, 9},
+				{6, 3, 9, 3, 9},
+				{4, 9, 13, 9, 13},
+				{1, 0, 1, 8, 9},
+			}
+			helpers.AssertEqual(t, helpers.ToString(s.Tasks), helpers.ToString(want))
+			helpers.AssertEqual(t, s.Tasks[1].Slack(), 4)
+			helpers.AssertEqual(t, helpers.ToString(s.Critical()), "[0 2 3]")
+			helpers.AssertEqual(t, helpers.ToString(collect(s)), "[[0 2 3]]")
+		})
+	}
+
+	t.Run("fractional durations", func(t *testing.T) {
+		g := adjacencylist.NewWeighted[float64](datastructures.Options{TotalVertices: 3})
+		g.AddEdge(0, 2, 1)
+		g.AddEdge(1, 2, 1)
+		s, err := FromVertices(g, []float64{1.5, 0.25, 2})
+		helpers.AssertEqual(t, err, nil)
+		helpers.AssertEqual(t, s.Length, 3.5)
+		helpers.AssertEqual(t, s.Tasks[1].Slack(), 1.25)
+		helpers.AssertEqual(t, helpers.ToString(collect(s)), "[[0 2]]")
+	})
+
+	t.Run("wrong number of durations", func(t *testing.T) {
+		g := adjacencylist.New(datastructures.Options{TotalVertices: 2})
+		_, err := FromVertices(g, []int{1})
+		helpers.Assert(t, err != nil)
+	})
+}
+
+func TestFromEdges(t *testing.T) {
+	g := adjacencylist.New(datastructures.Options{TotalVertices: 6})
+	g.AddEdge(0, 1, 3)
+	g.AddEdge(0, 2, 2)
+	g.AddEdge(1, 3, 4)
+	g.AddEdge(2, 3, 5)
+	g.AddEdge(2, 4, 3)
+	g.AddEdge(3, 5, 2)
+	g.AddEdge(4, 5, 1)
+
+	s, err := FromEdges(g)
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, s.Length, 9)
+
+	events := helpers.Map(s.Tasks, func(t Task[int]) [2]int { return [2]int{t.EarliestStart, t.LatestStart} })
+	helpers.AssertEqual(t, helpers.ToString(events), "[[0 0] [3 3] [2 2] [7 7] [5 8] [9 9]]")
+
+	a, ok := s.Activity(2, 4)
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, a, Task[int]{3, 2, 5, 5, 8})
+	_, ok = s.Activity(4, 2)
+	helpers.Assert(t, !ok)
+
+	// both routes into 3 take 7, so there are two critical paths
+	paths := collect(s)
+	slices.SortFunc(paths, slices.Compare)
+	helpers.AssertEqual(t, helpers.ToString(paths), "[[0 1 3 5] [0 2 3 5]]")
+
+	t.Run("stops early", func(t *testing.T) {
+		n := 0
+		for range s.CriticalPaths() {
+			n++
+			break
+		}
+		helpers.AssertEqual(t, n, 1)
+	})
+}
+
+func TestCycle(t *testing.T) {
+	g := adjacencylist.New(datastructures.Options{TotalVertices: 4})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 1, 1)
+
+	_, err := FromEdges(g)
+	var c *CycleError
+	helpers.Assert(t, errors.As(err, &c))
+	got := append([]int{}, c.Cycle...)
+	slices.Sort(got)
+	helpers.AssertEqual(t, helpers.ToString(got), "[1 2 3]")
+	for i, u := range c.Cycle {
+		helpers.Assert(t, g.Adjacent(u, c.Cycle[(i+1)%len(c.Cycle)]))
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
 )
 
-func Run(g datastructures.Graph, src int) []*graphs.Vertex {
+func Run(g datastructures.Topology, src int) []*graphs.Vertex {
 	fmt.Printf("Running DFS on the following graph:\n%v\n\n", g)
 
 	vertices := make([]*graphs.Vertex, g.Size())
@@ -21,7 +21,7 @@ func Run(g datastructures.Graph, src int) []*graphs.Vertex {
 	return vertices
 }
 
-func Visit(g datastructures.Graph, vertices []*graphs.Vertex, u int, time *int) {
+func Visit(g datastructures.Topology, vertices []*graphs.Vertex, u int, time *int) {
 	*time++
 	discovered := vertices[u]
 	discovered.Color = graphs.Gray
@@ -35,7 +35,6 @@ func Visit(g datastructures.Graph, vertices []*graphs.Vertex, u int, time *int)
 	*time++
 	discovered.Color = graphs.Black
 	discovered.Dist = float64(*time)
-	fmt.Println(discovered)
 }
 
 func Demo() {
@@ -0,0 +1,97 @@
+package dijkstra
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mhrdini/godsa/algorithms/graphs"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/trees/heaps/binaryheap"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+)
+
+// Single-source shortest paths on graphs with non-negative edge weights.
+
+type item struct {
+	vertex int
+	dist   float64
+}
+
+func compareItems(a, b item) int {
+	return comparator.OrderedComparator(a.dist, b.dist)
+}
+
+// Run returns every vertex with its distance from src and its parent on a
+// shortest path. Unreachable vertices stay white with an infinite distance.
+func Run[W datastructures.Number](g datastructures.WeightedGraph[W], src int) []*graphs.Vertex {
+	return RunFiltered(g, src, func(u, v int) bool { return true })
+}
+
+// RunFiltered is Run restricted to the edges (u, v) for which allowed returns
+// true.
+func RunFiltered[W datastructures.Number](g datastructures.WeightedGraph[W], src int, allowed func(u, v int) bool) []*graphs.Vertex {
+	vertices := make([]*graphs.Vertex, g.Size())
+	for i := 0; i < g.Size(); i++ {
+		vertices[i] = &graphs.Vertex{Color: graphs.White, Value: i, Dist: math.Inf(1), Parent: nil}
+	}
+	vertices[src].Color = graphs.Gray
+	vertices[src].Dist = 0
+
+	h := binaryheap.MinHeap(compareItems, item{src, 0})
+	for !h.Empty() {
+		it, _ := h.Pop()
+		u := vertices[it.vertex]
+		if u.Color == graphs.Black {
+			continue
+		}
+		u.Color = graphs.Black
+		for _, v := range g.Neighbors(u.Value) {
+			neighbor := vertices[v]
+			if neighbor.Color == graphs.Black || !allowed(u.Value, v) {
+				continue
+			}
+			w, _ := g.Weight(u.Value, v)
+			if alt := u.Dist + float64(w); alt < neighbor.Dist {
+				neighbor.Color = graphs.Gray
+				neighbor.Dist = alt
+				neighbor.Parent = u
+				h.Add(item{v, alt})
+			}
+		}
+	}
+	return vertices
+}
+
+// Path returns the vertices on the shortest path to dst found by Run, or false
+// if dst is unreachable.
+func Path(vertices []*graphs.Vertex, dst int) ([]int, bool) {
+	if math.IsInf(vertices[dst].Dist, 1) {
+		return nil, false
+	}
+	path := []int{}
+	for v := vertices[dst]; v != nil; v = v.Parent {
+		path = append([]int{v.Value}, path...)
+	}
+	return path, true
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 6,
+		Undirected:    false,
+	})
+	g.AddEdge(0, 1, 3)
+	g.AddEdge(0, 2, 2)
+	g.AddEdge(1, 3, 4)
+	g.AddEdge(2, 1, 1)
+	g.AddEdge(2, 3, 2)
+	g.AddEdge(2, 4, 3)
+	g.AddEdge(3, 4, 2)
+	g.AddEdge(3, 5, 1)
+	g.AddEdge(4, 5, 2)
+
+	vertices := Run(g, 0)
+	fmt.Println(vertices)
+	fmt.Println(Path(vertices, 5))
+}
@@ -0,0 +1,55 @@
+package dijkstra
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestRun(t *testing.T) {
+	o := datastructures.Options{TotalVertices: 5}
+	for _, g := range []datastructures.Graph{adjacencylist.New(o), adjacencymatrix.New(o)} {
+		g.AddEdge(0, 1, 4)
+		g.AddEdge(0, 2, 1)
+		g.AddEdge(2, 1, 2)
+		g.AddEdge(1, 3, 1)
+		g.AddEdge(2, 3, 5)
+
+		t.Run(g.Name(), func(t *testing.T) {
+			vertices := Run(g, 0)
+			dists := []float64{}
+			for _, v := range vertices {
+				dists = append(dists, v.Dist)
+			}
+			helpers.AssertEqual(t, fmt.Sprint(dists), fmt.Sprint([]float64{0, 3, 1, 4, math.Inf(1)}))
+
+			path, ok := Path(vertices, 3)
+			helpers.Assert(t, ok)
+			helpers.AssertEqual(t, helpers.ToString(path), "[0 2 1 3]")
+
+			_, ok = Path(vertices, 4)
+			helpers.Assert(t, !ok)
+		})
+	}
+}
+
+func TestRunFloatWeights(t *testing.T) {
+	o := datastructures.Options{TotalVertices: 3, Undirected: true}
+	for _, g := range []datastructures.WeightedGraph[float64]{adjacencylist.NewWeighted[float64](o), adjacencymatrix.NewWeighted[float64](o)} {
+		g.AddEdge(0, 1, 0.5)
+		g.AddEdge(1, 2, 0.25)
+		g.AddEdge(0, 2, 1)
+
+		t.Run(g.Name(), func(t *testing.T) {
+			vertices := Run(g, 0)
+			helpers.AssertEqual(t, vertices[2].Dist, 0.75)
+			path, _ := Path(vertices, 2)
+			helpers.AssertEqual(t, helpers.ToString(path), "[0 1 2]")
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package dominators
+
+import (
+	"fmt"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/trees"
+)
+
+// A vertex d dominates v if every path from the entry to v passes through d.
+// The immediate dominator of v is the unique dominator of v, other than v,
+// that is dominated by all the others. Linking every vertex to its immediate
+// dominator forms the dominator tree, rooted at the entry.
+//
+// Only vertices reachable from the entry take part: every other vertex has no
+// immediate dominator and is left out of the tree.
+
+// Tree is the dominator tree of a graph.
+type Tree struct {
+	entry    int
+	idom     []int // immediate dominator of each vertex, -1 for the entry and unreachable vertices
+	children [][]int
+	pre      []int // preorder and postorder numbers, to answer Dominates in O(1)
+	post     []int
+}
+
+// New builds the dominator tree of g from entry using Lengauer-Tarjan.
+func New(g datastructures.Topology, entry int) *Tree {
+	return newTree(entry, LengauerTarjan(g, entry))
+}
+
+// PostDominators builds the post-dominator tree of g: d post-dominates v if
+// every path from v to exit passes through d.
+func PostDominators[W datastructures.Number](g datastructures.WeightedGraph[W], exit int) *Tree {
+	return New(g.Transpose(), exit)
+}
+
+func newTree(entry int, idom []int) *Tree {
+	n := len(idom)
+	t := &Tree{
+		entry:    entry,
+		idom:     idom,
+		children: make([][]int, n),
+		pre:      make([]int, n),
+		post:     make([]int, n),
+	}
+	for v, d := range idom {
+		if d != -1 {
+			t.children[d] = append(t.children[d], v)
+		}
+	}
+
+	// iterative DFS: next[v] is the index of the next child of v to visit
+	next := make([]int, n)
+	stack := []int{entry}
+	pre, post := 0, 0
+	t.pre[entry] = pre
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		if next[v] < len(t.children[v]) {
+			c := t.children[v][next[v]]
+			next[v]++
+			pre++
+			t.pre[c] = pre
+			stack = append(stack, c)
+			continue
+		}
+		t.post[v] = post
+		post++
+		stack = stack[:len(stack)-1]
+	}
+	return t
+}
+
+func (t *Tree) Entry() int {
+	return t.entry
+}
+
+// Idom returns the immediate dominator of v. It fails for the entry and for
+// unreachable vertices.
+func (t *Tree) Idom(v int) (int, bool) {
+	return t.idom[v], t.idom[v] != -1
+}
+
+// Idoms returns the immediate dominator of every vertex, -1 for the entry and
+// unreachable vertices.
+func (t *Tree) Idoms() []int {
+	return append([]int{}, t.idom...)
+}
+
+func (t *Tree) Reachable(v int) bool {
+	return v == t.entry || t.idom[v] != -1
+}
+
+// Dominates reports whether a dominates b. Every reachable vertex dominates
+// itself.
+func (t *Tree) Dominates(a, b int) bool {
+	if !t.Reachable(a) || !t.Reachable(b) {
+		return false
+	}
+	return t.pre[a] <= t.pre[b] && t.post[b] <= t.post[a]
+}
+
+// Frontiers returns the dominance frontier of every vertex of g: the vertices
+// w such that v dominates a predecessor of w but does not strictly dominate w.
+// These are where SSA construction places phi functions. t must be the
+// dominator tree of g.
+func (t *Tree) Frontiers(g datastructures.Topology) [][]int {
+	n := g.Size()
+	preds := predecessors(g)
+	frontiers := make([][]int, n)
+	for w := 0; w < n; w++ {
+		// the entry has an implicit extra predecessor: the start of the program
+		if !t.Reachable(w) || len(preds[w]) < 2 && w != t.entry {
+			continue
+		}
+		for _, p := range preds[w] {
+			if !t.Reachable(p) {
+				continue
+			}
+			for runner := p; runner != t.idom[w]; runner = t.idom[runner] {
+				if l := len(frontiers[runner]); l == 0 || frontiers[runner][l-1] != w {
+					frontiers[runner] = append(frontiers[runner], w)
+				}
+				if runner == t.entry {
+					break
+				}
+			}
+		}
+	}
+	return frontiers
+}
+
+func (t *Tree) Root() trees.INode[int] {
+	return &Node{t, t.entry}
+}
+
+// Node is a vertex of the dominator tree.
+type Node struct {
+	tree   *Tree
+	vertex int
+}
+
+func (n *Node) Value() (value int, ok bool) {
+	if n == nil {
+		return
+	}
+	return n.vertex, true
+}
+
+func (n *Node) Children() []trees.INode[int] {
+	children := []trees.INode[int]{}
+	for _, c := range n.tree.children[n.vertex] {
+		children = append(children, &Node{n.tree, c})
+	}
+	return children
+}
+
+func (n *Node) IsNil() bool {
+	return n == nil
+}
+
+func predecessors(g datastructures.Topology) [][]int {
+	preds := make([][]int, g.Size())
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			preds[v] = append(preds[v], u)
+		}
+	}
+	return preds
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 6,
+		Undirected:    false,
+	})
+	// a loop 1 -> 3 -> 4 -> 1 entered from both branches of 0
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 1, 1)
+	g.AddEdge(4, 5, 1)
+
+	t := New(g, 0)
+	fmt.Println("idom:      ", t.Idoms())
+	fmt.Println("frontiers: ", t.Frontiers(g))
+	fmt.Println("post idom: ", PostDominators(g, 5).Idoms())
+}
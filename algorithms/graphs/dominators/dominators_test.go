@@ -0,0 +1,135 @@
+package dominators
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/datastructures/trees"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// a loop 1 -> 3 -> 4 -> 1 entered from both branches of 0, exiting at 5; 6 is
+// unreachable
+var loop = [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 1}, {4, 5}, {6, 5}}
+
+// Figure 2 of Lengauer and Tarjan, with R, A, B, ..., L numbered 0, 1, 2, ...
+var lengauerTarjan = [][2]int{
+	{0, 1}, {0, 2}, {0, 3}, {1, 4}, {2, 1}, {2, 4}, {2, 5}, {3, 6}, {3, 7},
+	{4, 12}, {5, 8}, {6, 9}, {7, 9}, {7, 10}, {8, 5}, {8, 11}, {9, 11},
+	{10, 9}, {11, 0}, {11, 9}, {12, 8},
+}
+
+// naive removes each vertex in turn and sees what becomes unreachable.
+func naive(g datastructures.Graph, entry int) [][]bool {
+	n := g.Size()
+	reachable := func(removed int) []bool {
+		seen := make([]bool, n)
+		if removed == entry {
+			return seen
+		}
+		seen[entry] = true
+		stack := []int{entry}
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, v := range g.Neighbors(u) {
+				if v != removed && !seen[v] {
+					seen[v] = true
+					stack = append(stack, v)
+				}
+			}
+		}
+		return seen
+	}
+	all := reachable(-1)
+	dominates := make([][]bool, n)
+	for d := 0; d < n; d++ {
+		without := reachable(d)
+		dominates[d] = make([]bool, n)
+		for v := 0; v < n; v++ {
+			dominates[d][v] = all[d] && all[v] && (d == v || !without[v])
+		}
+	}
+	return dominates
+}
+
+func TestIdoms(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		n     int
+		edges [][2]int
+		want  string
+	}{
+		{"loop", 7, loop, "[-1 0 0 0 3 4 -1]"},
+		{"lengauer tarjan", 13, lengauerTarjan, "[-1 0 0 0 0 0 3 3 0 0 7 0 4]"},
+		{"single vertex", 1, [][2]int{}, "[-1]"},
+	}
+
+	for _, tc := range testCases {
+		g := graphtest.Build(tc.n, false, graphtest.Unweighted(tc.edges))
+		t.Run(tc.desc+" with Lengauer-Tarjan", func(t *testing.T) {
+			helpers.AssertEqual(t, helpers.ToString(LengauerTarjan(g, 0)), tc.want)
+		})
+		t.Run(tc.desc+" with Cooper-Harvey-Kennedy", func(t *testing.T) {
+			helpers.AssertEqual(t, helpers.ToString(Iterative(g, 0)), tc.want)
+		})
+	}
+}
+
+func TestRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		n := 1 + rng.Intn(20)
+		g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n)})
+		for i := 0; i < rng.Intn(3*n); i++ {
+			g.AddEdge(rng.Intn(n), rng.Intn(n), 1)
+		}
+		entry := rng.Intn(n)
+		t.Run(fmt.Sprint(trial), func(t *testing.T) {
+			lt := LengauerTarjan(g, entry)
+			helpers.AssertEqual(t, helpers.ToString(Iterative(g, entry)), helpers.ToString(lt))
+
+			tree := New(g, entry)
+			want := naive(g, entry)
+			for a := 0; a < n; a++ {
+				for b := 0; b < n; b++ {
+					helpers.AssertEqual(t, tree.Dominates(a, b), want[a][b])
+				}
+			}
+		})
+	}
+}
+
+func TestPostDominators(t *testing.T) {
+	tree := PostDominators(graphtest.Build(7, false, graphtest.Unweighted(loop)), 5)
+	helpers.AssertEqual(t, helpers.ToString(tree.Idoms()), "[3 3 3 4 5 -1 5]")
+	helpers.Assert(t, tree.Dominates(4, 0))
+	helpers.Assert(t, !tree.Dominates(1, 0))
+}
+
+func TestFrontiers(t *testing.T) {
+	g := graphtest.Build(7, false, graphtest.Unweighted(loop))
+	helpers.AssertEqual(t, helpers.ToString(New(g, 0).Frontiers(g)), "[[] [3] [3] [1] [1] [] []]")
+
+	// the entry is in its own frontier when the loop returns to it
+	g = graphtest.Build(3, false, graphtest.Unweighted([][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 2}}))
+	helpers.AssertEqual(t, helpers.ToString(New(g, 0).Frontiers(g)), "[[0] [2] [0]]")
+}
+
+func TestRoot(t *testing.T) {
+	tree := New(graphtest.Build(7, false, graphtest.Unweighted(loop)), 0)
+	ch := make(chan int)
+	go func() {
+		trees.PreOrder(tree.Root(), ch)
+		close(ch)
+	}()
+	got := []int{}
+	for v := range ch {
+		got = append(got, v)
+	}
+	helpers.AssertEqual(t, helpers.ToString(got), "[0 1 2 3 4 5]")
+}
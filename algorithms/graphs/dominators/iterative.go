@@ -0,0 +1,90 @@
+package dominators
+
+import (
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Iterative returns the immediate dominator of every vertex of g, -1 for entry
+// and unreachable vertices, using the algorithm of Cooper, Harvey and Kennedy.
+//
+// Vertices are visited in reverse postorder and each one takes as its
+// immediate dominator the nearest common ancestor, in the current tree, of
+// its processed predecessors, until nothing changes. It is O(V^2) in the worst
+// case but simple, and fast on the reducible graphs compilers produce.
+func Iterative(g datastructures.Topology, entry int) []int {
+	n := g.Size()
+	postorder := postorderNumbering(g, entry)
+	rpo := make([]int, len(postorder))
+	for v, i := range postorder {
+		rpo[len(rpo)-1-i] = v
+	}
+
+	idom := make([]int, n)
+	for v := range idom {
+		idom[v] = -1
+	}
+	idom[entry] = entry
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for postorder[a] < postorder[b] {
+				a = idom[a]
+			}
+			for postorder[b] < postorder[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	preds := predecessors(g)
+	for changed := true; changed; {
+		changed = false
+		for _, b := range rpo[1:] {
+			candidate := -1
+			for _, p := range preds[b] {
+				if idom[p] == -1 {
+					continue
+				}
+				if candidate == -1 {
+					candidate = p
+				} else {
+					candidate = intersect(p, candidate)
+				}
+			}
+			if idom[b] != candidate {
+				idom[b] = candidate
+				changed = true
+			}
+		}
+	}
+	idom[entry] = -1
+	return idom
+}
+
+// postorderNumbering returns the DFS postorder number of every vertex
+// reachable from entry.
+func postorderNumbering(g datastructures.Topology, entry int) map[int]int {
+	postorder := map[int]int{}
+	visited := map[int]bool{entry: true}
+	type frame struct {
+		vertex    int
+		neighbors []int
+	}
+	stack := []*frame{{entry, g.Neighbors(entry)}}
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		if len(f.neighbors) > 0 {
+			v := f.neighbors[0]
+			f.neighbors = f.neighbors[1:]
+			if !visited[v] {
+				visited[v] = true
+				stack = append(stack, &frame{v, g.Neighbors(v)})
+			}
+			continue
+		}
+		postorder[f.vertex] = len(postorder)
+		stack = stack[:len(stack)-1]
+	}
+	return postorder
+}
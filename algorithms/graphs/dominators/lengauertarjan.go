@@ -0,0 +1,121 @@
+package dominators
+
+import (
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// LengauerTarjan returns the immediate dominator of every vertex of g, -1 for
+// entry and unreachable vertices, in O(E log V).
+//
+// Vertices are numbered in DFS order. The semidominator of w is the vertex
+// with the smallest number from which w can be reached through vertices
+// numbered higher than w, and it is computed in reverse DFS order over a
+// forest with path compression. Immediate dominators then follow from the
+// semidominators in one more pass.
+func LengauerTarjan(g datastructures.Topology, entry int) []int {
+	n := g.Size()
+	idom := make([]int, n)
+	for v := range idom {
+		idom[v] = -1
+	}
+
+	// everything below is indexed by DFS number rather than by vertex
+	order, parent, number := dfsNumbering(g, entry)
+	m := len(order)
+	semi := make([]int, m)
+	label := make([]int, m)
+	ancestor := make([]int, m)
+	dom := make([]int, m)
+	bucket := make([][]int, m)
+	for i := 0; i < m; i++ {
+		semi[i] = i
+		label[i] = i
+		ancestor[i] = -1
+	}
+
+	// compress shortcuts the forest path from i to its root, keeping in
+	// label[i] the vertex with the smallest semidominator along the way
+	compress := func(i int) {
+		chain := []int{}
+		for x := i; ancestor[ancestor[x]] != -1; x = ancestor[x] {
+			chain = append(chain, x)
+		}
+		for j := len(chain) - 1; j >= 0; j-- {
+			x := chain[j]
+			a := ancestor[x]
+			if semi[label[a]] < semi[label[x]] {
+				label[x] = label[a]
+			}
+			ancestor[x] = ancestor[a]
+		}
+	}
+	eval := func(i int) int {
+		if ancestor[i] == -1 {
+			return i
+		}
+		compress(i)
+		return label[i]
+	}
+
+	preds := predecessors(g)
+	for i := m - 1; i > 0; i-- {
+		w := order[i]
+		for _, v := range preds[w] {
+			if j, ok := number[v]; ok {
+				if u := eval(j); semi[u] < semi[i] {
+					semi[i] = semi[u]
+				}
+			}
+		}
+		bucket[semi[i]] = append(bucket[semi[i]], i)
+		p := parent[i]
+		ancestor[i] = p
+		for _, v := range bucket[p] {
+			if u := eval(v); semi[u] < semi[v] {
+				dom[v] = u
+			} else {
+				dom[v] = p
+			}
+		}
+		bucket[p] = nil
+	}
+	for i := 1; i < m; i++ {
+		if dom[i] != semi[i] {
+			dom[i] = dom[dom[i]]
+		}
+		idom[order[i]] = order[dom[i]]
+	}
+	return idom
+}
+
+// dfsNumbering numbers the vertices reachable from entry in DFS preorder. It
+// returns the vertex with each number, the number of each DFS parent, and the
+// number of each vertex.
+func dfsNumbering(g datastructures.Topology, entry int) ([]int, []int, map[int]int) {
+	order := []int{}
+	parent := []int{}
+	number := map[int]int{}
+
+	type frame struct {
+		vertex int
+		parent int // DFS number of the parent
+	}
+	stack := []frame{{entry, -1}}
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := number[f.vertex]; ok {
+			continue
+		}
+		number[f.vertex] = len(order)
+		order = append(order, f.vertex)
+		parent = append(parent, f.parent)
+		neighbors := g.Neighbors(f.vertex)
+		for i := len(neighbors) - 1; i >= 0; i-- {
+			if _, ok := number[neighbors[i]]; !ok {
+				stack = append(stack, frame{neighbors[i], number[f.vertex]})
+			}
+		}
+	}
+	return order, parent, number
+}
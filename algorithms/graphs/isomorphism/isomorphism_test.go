@@ -0,0 +1,231 @@
+package isomorphism
+
+import (
+	"iter"
+	"math/rand"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func random(rng *rand.Rand, n int, p float64) datastructures.Graph {
+	g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n)})
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if rng.Float64() < p {
+				g.AddEdge(u, v, 1+rng.Intn(2))
+			}
+		}
+	}
+	return g
+}
+
+// permute returns g with vertex v renamed to perm[v].
+func permute(g datastructures.Graph, perm []int) datastructures.Graph {
+	h := adjacencymatrix.New(datastructures.Options{TotalVertices: uint32(g.Size())})
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			w, _ := g.Weight(u, v)
+			h.AddEdge(perm[u], perm[v], w)
+		}
+	}
+	return h
+}
+
+// valid reports whether mapping is an induced subgraph isomorphism, which is
+// an isomorphism when both graphs have the same size.
+func valid(g1, g2 datastructures.Graph, mapping []int, o Options) bool {
+	used := map[int]bool{}
+	for u, v := range mapping {
+		if used[v] || (o.VertexMatch != nil && !o.VertexMatch(u, v)) {
+			return false
+		}
+		used[v] = true
+	}
+	for u := range mapping {
+		for v := range mapping {
+			if g1.Adjacent(u, v) != g2.Adjacent(mapping[u], mapping[v]) {
+				return false
+			}
+			if g1.Adjacent(u, v) && o.EdgeMatch != nil && !o.EdgeMatch(u, v, mapping[u], mapping[v]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// bruteForce counts the injections from g1 into g2 that pass valid.
+func bruteForce(g1, g2 datastructures.Graph, o Options) int {
+	mapping := []int{}
+	used := make([]bool, g2.Size())
+	var extend func() int
+	extend = func() int {
+		if len(mapping) == g1.Size() {
+			if valid(g1, g2, mapping, o) {
+				return 1
+			}
+			return 0
+		}
+		total := 0
+		for v := range used {
+			if !used[v] {
+				used[v] = true
+				mapping = append(mapping, v)
+				total += extend()
+				mapping = mapping[:len(mapping)-1]
+				used[v] = false
+			}
+		}
+		return total
+	}
+	return extend()
+}
+
+func size(seq iter.Seq[[]int]) int {
+	n := 0
+	seq(func([]int) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+func TestIsomorphism(t *testing.T) {
+	cycle := []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 0, 1}}
+
+	t.Run("directed cycle", func(t *testing.T) {
+		g1 := graphtest.Build(4, false, cycle)
+		g2 := permute(g1, []int{2, 0, 3, 1})
+		mapping, ok := Isomorphism(g1, g2, Options{})
+		helpers.Assert(t, ok)
+		helpers.Assert(t, valid(g1, g2, mapping, Options{}))
+		// the rotations of the cycle
+		helpers.AssertEqual(t, size(Isomorphisms(g1, g2, Options{})), 4)
+	})
+
+	t.Run("reversed edge", func(t *testing.T) {
+		g1 := graphtest.Build(4, false, cycle)
+		g2 := graphtest.Build(4, false, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {0, 3, 1}})
+		_, ok := Isomorphism(g1, g2, Options{})
+		helpers.Assert(t, !ok)
+	})
+
+	t.Run("undirected cycle", func(t *testing.T) {
+		g := graphtest.Build(4, true, cycle)
+		helpers.AssertEqual(t, size(Isomorphisms(g, g, Options{})), 8)
+	})
+
+	t.Run("vertex labels", func(t *testing.T) {
+		g := graphtest.Build(4, true, cycle)
+		labels := []string{"a", "b", "a", "b"}
+		o := Options{VertexMatch: func(u, v int) bool { return labels[u] == labels[v] }}
+		helpers.AssertEqual(t, size(Isomorphisms(g, g, o)), 4)
+	})
+
+	t.Run("edge weights", func(t *testing.T) {
+		g1 := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 2}, {2, 0, 3}})
+		g2 := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 3}, {2, 0, 2}})
+		helpers.AssertEqual(t, size(Isomorphisms(g1, g2, Options{})), 6)
+		mapping, ok := Isomorphism(g1, g2, Options{EdgeMatch: SameWeight(g1, g2)})
+		helpers.Assert(t, ok)
+		helpers.AssertEqual(t, helpers.ToString(mapping), "[1 0 2]")
+
+		g3 := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 0, 2}})
+		_, ok = Isomorphism(g1, g3, Options{EdgeMatch: SameWeight(g1, g3)})
+		helpers.Assert(t, !ok)
+	})
+
+	t.Run("random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 100; trial++ {
+			n := 1 + rng.Intn(6)
+			g1 := random(rng, n, 0.3)
+			g2 := permute(g1, rng.Perm(n))
+			if trial%2 == 1 {
+				g2 = random(rng, n, 0.3)
+			}
+			o := Options{}
+			if trial%3 == 0 {
+				o.EdgeMatch = SameWeight(g1, g2)
+			}
+
+			want := bruteForce(g1, g2, o)
+			helpers.AssertEqual(t, size(Isomorphisms(g1, g2, o)), want)
+			mapping, ok := Isomorphism(g1, g2, o)
+			helpers.AssertEqual(t, ok, want > 0)
+			if ok {
+				helpers.Assert(t, valid(g1, g2, mapping, o))
+				helpers.AssertEqual(t, Hash(g1), Hash(g2))
+			}
+		}
+	})
+}
+
+func TestSubgraphIsomorphism(t *testing.T) {
+	t.Run("triangle in a square with a diagonal", func(t *testing.T) {
+		triangle := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}})
+		g := graphtest.Build(4, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 0, 1}, {0, 2, 1}})
+		// two triangles, each matched in 3! ways
+		helpers.AssertEqual(t, size(SubgraphIsomorphisms(triangle, g, Options{})), 12)
+
+		// a path of length 2 is only an induced subgraph around the
+		// vertices the diagonal misses
+		path := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}})
+		helpers.AssertEqual(t, size(SubgraphIsomorphisms(path, g, Options{})), 4)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, ok := SubgraphIsomorphism(graphtest.Build(3, false, nil), graphtest.Build(2, false, nil), Options{})
+		helpers.Assert(t, !ok)
+	})
+
+	t.Run("random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(2))
+		for trial := 0; trial < 100; trial++ {
+			n2 := 1 + rng.Intn(6)
+			n1 := 1 + rng.Intn(min(n2, 4))
+			pattern, g := random(rng, n1, 0.4), random(rng, n2, 0.4)
+			o := Options{}
+			if trial%3 == 0 {
+				o.EdgeMatch = SameWeight(pattern, g)
+			}
+
+			want := bruteForce(pattern, g, o)
+			helpers.AssertEqual(t, size(SubgraphIsomorphisms(pattern, g, o)), want)
+			mapping, ok := SubgraphIsomorphism(pattern, g, o)
+			helpers.AssertEqual(t, ok, want > 0)
+			if ok {
+				helpers.Assert(t, valid(pattern, g, mapping, o))
+			}
+		}
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		g := graphtest.Build(4, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 0, 1}})
+		n := 0
+		for range SubgraphIsomorphisms(graphtest.Build(1, true, nil), g, Options{}) {
+			n++
+			break
+		}
+		helpers.AssertEqual(t, n, 1)
+	})
+}
+
+func TestHash(t *testing.T) {
+	path := graphtest.Build(4, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}})
+	star := graphtest.Build(4, true, []graphtest.Edge{{0, 1, 1}, {0, 2, 1}, {0, 3, 1}})
+	helpers.Assert(t, Hash(path) != Hash(star))
+	helpers.AssertEqual(t, Hash(path), Hash(permute(path, []int{3, 1, 0, 2})))
+
+	// colour refinement cannot tell regular graphs of the same degree apart
+	hexagon := graphtest.Build(6, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 4, 1}, {4, 5, 1}, {5, 0, 1}})
+	triangles := graphtest.Build(6, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}, {3, 4, 1}, {4, 5, 1}, {5, 3, 1}})
+	helpers.AssertEqual(t, Hash(hexagon), Hash(triangles))
+	_, ok := Isomorphism(hexagon, triangles, Options{})
+	helpers.Assert(t, !ok)
+}
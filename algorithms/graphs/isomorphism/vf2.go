@@ -0,0 +1,299 @@
+package isomorphism
+
+import (
+	"fmt"
+	"iter"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+// Options restricts which vertices and edges may be mapped onto each other.
+// Vertices and edges of the first graph come first in each predicate; a nil
+// predicate accepts every pair.
+type Options struct {
+	VertexMatch func(u, v int) bool
+	EdgeMatch   func(u1, v1, u2, v2 int) bool
+}
+
+// SameWeight returns an edge predicate that only maps edges of g1 onto edges
+// of g2 with the same weight.
+func SameWeight[W datastructures.Number](g1, g2 datastructures.WeightedGraph[W]) func(u1, v1, u2, v2 int) bool {
+	return func(u1, v1, u2, v2 int) bool {
+		w1, _ := g1.Weight(u1, v1)
+		w2, _ := g2.Weight(u2, v2)
+		return w1 == w2
+	}
+}
+
+// Isomorphism returns a bijection from the vertices of g1 to those of g2
+// under which (u, v) is an edge of g1 exactly when (mapping[u], mapping[v]) is
+// an edge of g2, if one exists.
+func Isomorphism(g1, g2 datastructures.Topology, o Options) ([]int, bool) {
+	return first(Isomorphisms(g1, g2, o))
+}
+
+// Isomorphisms yields every isomorphism from g1 to g2 using VF2. Graphs whose
+// sizes or Weisfeiler-Lehman hashes differ are rejected before searching, and
+// vertices are only paired with vertices of the same refined colour.
+func Isomorphisms(g1, g2 datastructures.Topology, o Options) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		h1, h2 := newGraph(g1), newGraph(g2)
+		if h1.size() != h2.size() || h1.edges() != h2.edges() {
+			return
+		}
+		colors1, rounds := refine(h1, -1)
+		colors2, _ := refine(h2, rounds)
+		if distinct(colors1) != distinct(colors2) || hash(h1) != hash(h2) {
+			return
+		}
+		m := newMatcher(h1, h2, o, true)
+		m.colors1, m.colors2 = colors1, colors2
+		m.match(yield)
+	}
+}
+
+// SubgraphIsomorphism returns an injection from the vertices of pattern into
+// those of g under which (u, v) is an edge of pattern exactly when
+// (mapping[u], mapping[v]) is an edge of g, i.e. pattern is isomorphic to the
+// subgraph of g induced by the mapped vertices, if one exists.
+func SubgraphIsomorphism(pattern, g datastructures.Topology, o Options) ([]int, bool) {
+	return first(SubgraphIsomorphisms(pattern, g, o))
+}
+
+// SubgraphIsomorphisms yields every induced subgraph isomorphism from pattern
+// into g using VF2.
+func SubgraphIsomorphisms(pattern, g datastructures.Topology, o Options) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		h1, h2 := newGraph(pattern), newGraph(g)
+		if h1.size() > h2.size() || h1.edges() > h2.edges() {
+			return
+		}
+		newMatcher(h1, h2, o, false).match(yield)
+	}
+}
+
+func first(seq iter.Seq[[]int]) ([]int, bool) {
+	for mapping := range seq {
+		return mapping, true
+	}
+	return nil, false
+}
+
+// matcher holds the VF2 search state. core1 and core2 hold the partial
+// mapping in both directions, with -1 for unmapped vertices. in and out hold,
+// for every vertex that is mapped or a predecessor or successor of a mapped
+// vertex, the depth at which it joined that terminal set, and 0 otherwise.
+type matcher struct {
+	g1, g2 *graph
+	o      Options
+	exact  bool
+
+	colors1, colors2 []uint64
+
+	core1, core2 []int
+	in1, out1    []int
+	in2, out2    []int
+	depth        int
+}
+
+func newMatcher(g1, g2 *graph, o Options, exact bool) *matcher {
+	m := &matcher{g1: g1, g2: g2, o: o, exact: exact}
+	n1, n2 := g1.size(), g2.size()
+	m.core1, m.in1, m.out1 = make([]int, n1), make([]int, n1), make([]int, n1)
+	m.core2, m.in2, m.out2 = make([]int, n2), make([]int, n2), make([]int, n2)
+	for i := range m.core1 {
+		m.core1[i] = -1
+	}
+	for i := range m.core2 {
+		m.core2[i] = -1
+	}
+	return m
+}
+
+// match extends the current mapping, returning false once yield has stopped.
+func (m *matcher) match(yield func([]int) bool) bool {
+	if m.depth == m.g1.size() {
+		return yield(append([]int{}, m.core1...))
+	}
+
+	// pair the lowest unmapped vertex of g1 in the first non-empty terminal
+	// set with every unmapped vertex of g2 in the same set
+	u, t2 := m.lowest(m.out1), m.out2
+	if u == -1 {
+		u, t2 = m.lowest(m.in1), m.in2
+	}
+	if u == -1 {
+		u, t2 = m.lowest(nil), nil
+	}
+
+	for v := range m.core2 {
+		if m.core2[v] != -1 || (t2 != nil && t2[v] == 0) {
+			continue
+		}
+		if !m.feasible(u, v) {
+			continue
+		}
+		m.add(u, v)
+		ok := m.match(yield)
+		m.remove(u, v)
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// lowest returns the lowest unmapped vertex of g1 in the terminal set t, or
+// among all vertices if t is nil.
+func (m *matcher) lowest(t []int) int {
+	for u, v := range m.core1 {
+		if v == -1 && (t == nil || t[u] != 0) {
+			return u
+		}
+	}
+	return -1
+}
+
+func (m *matcher) feasible(u, v int) bool {
+	g1, g2 := m.g1, m.g2
+	if m.exact && m.colors1[u] != m.colors2[v] {
+		return false
+	}
+	if !m.exact && (len(g1.out[u]) > len(g2.out[v]) || len(g1.in[u]) > len(g2.in[v])) {
+		return false
+	}
+	if g1.adjacent(u, u) != g2.adjacent(v, v) {
+		return false
+	}
+	if m.o.VertexMatch != nil && !m.o.VertexMatch(u, v) {
+		return false
+	}
+	if g1.adjacent(u, u) && m.o.EdgeMatch != nil && !m.o.EdgeMatch(u, u, v, v) {
+		return false
+	}
+
+	// every edge between u and a mapped vertex must have a compatible image,
+	// and every edge between v and a mapped vertex must have a preimage
+	for _, x := range g1.out[u] {
+		if y := m.core1[x]; y != -1 && x != u {
+			if !g2.adjacent(v, y) || (m.o.EdgeMatch != nil && !m.o.EdgeMatch(u, x, v, y)) {
+				return false
+			}
+		}
+	}
+	for _, x := range g1.in[u] {
+		if y := m.core1[x]; y != -1 && x != u {
+			if !g2.adjacent(y, v) || (m.o.EdgeMatch != nil && !m.o.EdgeMatch(x, u, y, v)) {
+				return false
+			}
+		}
+	}
+	for _, y := range g2.out[v] {
+		if x := m.core2[y]; x != -1 && y != v && !g1.adjacent(u, x) {
+			return false
+		}
+	}
+	for _, y := range g2.in[v] {
+		if x := m.core2[y]; x != -1 && y != v && !g1.adjacent(x, u) {
+			return false
+		}
+	}
+
+	// look ahead: u cannot have more unmapped neighbours in each terminal
+	// set, or outside them, than v
+	for _, pair := range [][2][]int{{g1.out[u], g2.out[v]}, {g1.in[u], g2.in[v]}} {
+		a := count(pair[0], m.core1, m.in1, m.out1)
+		b := count(pair[1], m.core2, m.in2, m.out2)
+		for i := range a {
+			if (m.exact && a[i] != b[i]) || a[i] > b[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// count returns how many unmapped vertices of vs are in the in terminal set,
+// the out terminal set and neither.
+func count(vs, core, in, out []int) [3]int {
+	c := [3]int{}
+	for _, v := range vs {
+		if core[v] != -1 {
+			continue
+		}
+		if in[v] != 0 {
+			c[0]++
+		}
+		if out[v] != 0 {
+			c[1]++
+		}
+		if in[v] == 0 && out[v] == 0 {
+			c[2]++
+		}
+	}
+	return c
+}
+
+func (m *matcher) add(u, v int) {
+	m.depth++
+	m.core1[u], m.core2[v] = v, u
+	mark(m.g1, u, m.depth, m.in1, m.out1)
+	mark(m.g2, v, m.depth, m.in2, m.out2)
+}
+
+func (m *matcher) remove(u, v int) {
+	unmark(m.g1, u, m.depth, m.in1, m.out1)
+	unmark(m.g2, v, m.depth, m.in2, m.out2)
+	m.core1[u], m.core2[v] = -1, -1
+	m.depth--
+}
+
+func mark(g *graph, u, depth int, in, out []int) {
+	if in[u] == 0 {
+		in[u] = depth
+	}
+	if out[u] == 0 {
+		out[u] = depth
+	}
+	for _, x := range g.in[u] {
+		if in[x] == 0 {
+			in[x] = depth
+		}
+	}
+	for _, x := range g.out[u] {
+		if out[x] == 0 {
+			out[x] = depth
+		}
+	}
+}
+
+func unmark(g *graph, u, depth int, in, out []int) {
+	for _, ts := range [][]int{in, out} {
+		if ts[u] == depth {
+			ts[u] = 0
+		}
+		for _, x := range g.in[u] {
+			if ts[x] == depth {
+				ts[x] = 0
+			}
+		}
+		for _, x := range g.out[u] {
+			if ts[x] == depth {
+				ts[x] = 0
+			}
+		}
+	}
+}
+
+func Demo() {
+	// a directed 4-cycle, and the same cycle relabelled
+	g1 := adjacencylist.New(datastructures.Options{TotalVertices: 4})
+	g2 := adjacencylist.New(datastructures.Options{TotalVertices: 4})
+	for i := 0; i < 4; i++ {
+		g1.AddEdge(i, (i+1)%4, 1)
+		g2.AddEdge((i*3)%4, (i*3+3)%4, 1)
+	}
+	fmt.Println(Hash(g1) == Hash(g2))
+	fmt.Println(Isomorphism(g1, g2, Options{}))
+}
@@ -0,0 +1,130 @@
+package isomorphism
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"slices"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// graph is an immutable copy of a datastructures.Topology with sorted successor
+// and predecessor lists, so that adjacency can be tested by binary search.
+type graph struct {
+	out [][]int
+	in  [][]int
+}
+
+func newGraph(g datastructures.Topology) *graph {
+	n := g.Size()
+	h := &graph{make([][]int, n), make([][]int, n)}
+	for u := 0; u < n; u++ {
+		for _, v := range g.Neighbors(u) {
+			h.out[u] = append(h.out[u], v)
+			h.in[v] = append(h.in[v], u)
+		}
+	}
+	for u := 0; u < n; u++ {
+		slices.Sort(h.out[u])
+		h.out[u] = slices.Compact(h.out[u])
+		slices.Sort(h.in[u])
+		h.in[u] = slices.Compact(h.in[u])
+	}
+	return h
+}
+
+func (g *graph) size() int {
+	return len(g.out)
+}
+
+func (g *graph) edges() int {
+	m := 0
+	for _, vs := range g.out {
+		m += len(vs)
+	}
+	return m
+}
+
+func (g *graph) adjacent(u, v int) bool {
+	_, ok := slices.BinarySearch(g.out[u], v)
+	return ok
+}
+
+// refine runs Weisfeiler-Lehman colour refinement: every round a vertex's
+// colour becomes a hash of its old colour and the multisets of colours of its
+// successors and predecessors. Colours are computed from content alone, so
+// isomorphic vertices of different graphs get the same colour after the same
+// number of rounds. With rounds < 0 it runs until no colour class splits and
+// reports how many rounds that took.
+func refine(g *graph, rounds int) ([]uint64, int) {
+	n := g.size()
+	colors := make([]uint64, n)
+	for v := range colors {
+		if g.adjacent(v, v) {
+			colors[v] = 1
+		}
+	}
+	classes := distinct(colors)
+
+	next := make([]uint64, n)
+	buf := []uint64{}
+	r := 0
+	for ; rounds < 0 || r < rounds; r++ {
+		for v := range next {
+			h := fnv.New64a()
+			write(h, colors[v])
+			for _, vs := range [][]int{g.out[v], g.in[v]} {
+				buf = buf[:0]
+				for _, u := range vs {
+					buf = append(buf, colors[u])
+				}
+				slices.Sort(buf)
+				write(h, uint64(len(buf)))
+				write(h, buf...)
+			}
+			next[v] = h.Sum64()
+		}
+		colors, next = next, colors
+
+		if rounds < 0 {
+			c := distinct(colors)
+			if c == classes {
+				// the colours were already stable before this round
+				return colors, r + 1
+			}
+			classes = c
+		}
+	}
+	return colors, r
+}
+
+func write(h interface{ Write([]byte) (int, error) }, xs ...uint64) {
+	b := make([]byte, 8)
+	for _, x := range xs {
+		binary.LittleEndian.PutUint64(b, x)
+		h.Write(b)
+	}
+}
+
+func distinct(colors []uint64) int {
+	sorted := slices.Clone(colors)
+	slices.Sort(sorted)
+	return len(slices.Compact(sorted))
+}
+
+// Hash returns a Weisfeiler-Lehman hash of the structure of g, ignoring edge
+// weights. Isomorphic graphs always have the same hash, so graphs with
+// different hashes are certainly not isomorphic; the converse does not hold,
+// e.g. for regular graphs of the same size and degree.
+func Hash(g datastructures.Topology) uint64 {
+	return hash(newGraph(g))
+}
+
+func hash(g *graph) uint64 {
+	colors, rounds := refine(g, -1)
+	slices.Sort(colors)
+	h := fnv.New64a()
+	write(h, uint64(g.size()), uint64(rounds))
+	write(h, colors...)
+	return h.Sum64()
+}
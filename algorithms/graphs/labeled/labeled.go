@@ -0,0 +1,117 @@
+// Package labeled runs the graph algorithms on a graphs.WeightedLabeledGraph
+// with keys in and keys out, so callers never handle the ids of its vertices.
+package labeled
+
+import (
+	"fmt"
+
+	"github.com/mhrdini/godsa/algorithms/graphs"
+	"github.com/mhrdini/godsa/algorithms/graphs/bfs"
+	"github.com/mhrdini/godsa/algorithms/graphs/dfs"
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Vertex is what an algorithm such as bfs.Run, dfs.Run or dijkstra.Run found
+// for one vertex, with the key of its parent in place of a pointer to it.
+type Vertex[K comparable] struct {
+	graphs.Color
+	Key  K
+	Dist float64
+	// Parent is the key of the parent of the vertex if HasParent is true,
+	// which it is for every reached vertex but the source.
+	Parent    K
+	HasParent bool
+}
+
+func (v Vertex[K]) String() string {
+	if !v.HasParent {
+		return fmt.Sprintf("[v:%v %v d:%v p:-]", v.Key, v.Color, v.Dist)
+	}
+	return fmt.Sprintf("[v:%v %v d:%v p:%v]", v.Key, v.Color, v.Dist, v.Parent)
+}
+
+// Vertices turns the vertices returned by an algorithm run on l.Graph() into
+// a map from their keys.
+func Vertices[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W], vertices []*graphs.Vertex) map[K]Vertex[K] {
+	m := make(map[K]Vertex[K], len(vertices))
+	for _, v := range vertices {
+		k, _ := l.Key(v.Value)
+		u := Vertex[K]{Color: v.Color, Key: k, Dist: v.Dist}
+		if v.Parent != nil {
+			u.Parent, _ = l.Key(v.Parent.Value)
+			u.HasParent = true
+		}
+		m[k] = u
+	}
+	return m
+}
+
+// Components turns sets of ids, such as the components returned by scc.Run on
+// l.Graph(), into sets of keys.
+func Components[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W], components [][]int) [][]K {
+	ks := make([][]K, len(components))
+	for i, c := range components {
+		ks[i] = l.KeysOf(c)
+	}
+	return ks
+}
+
+// Run runs an algorithm that starts from a source, such as dijkstra.Run[W],
+// on l.Graph() from the vertex labelled src.
+func Run[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W], src K, run func(g datastructures.WeightedGraph[W], src int) []*graphs.Vertex) (map[K]Vertex[K], error) {
+	id, ok := l.ID(src)
+	if !ok {
+		return nil, fmt.Errorf("error: no vertex is labelled %v", src)
+	}
+	return Vertices(l, run(l.Graph(), id)), nil
+}
+
+// RunComponents runs an algorithm that splits a graph into sets of vertices,
+// such as scc.Run, on l.Graph().
+func RunComponents[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W], run func(g datastructures.WeightedGraph[W]) [][]int) [][]K {
+	return Components(l, run(l.Graph()))
+}
+
+// BFS runs bfs.Run from the vertex labelled src.
+func BFS[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W], src K) (map[K]Vertex[K], error) {
+	return Run(l, src, func(g datastructures.WeightedGraph[W], src int) []*graphs.Vertex {
+		return bfs.Run(g, src)
+	})
+}
+
+// DFS runs dfs.Run from the vertex labelled src. The Dist of each vertex is
+// the time it finished.
+func DFS[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W], src K) (map[K]Vertex[K], error) {
+	return Run(l, src, func(g datastructures.WeightedGraph[W], src int) []*graphs.Vertex {
+		return dfs.Run(g, src)
+	})
+}
+
+// Dijkstra runs dijkstra.Run from the vertex labelled src.
+func Dijkstra[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W], src K) (map[K]Vertex[K], error) {
+	return Run(l, src, dijkstra.Run[W])
+}
+
+// SCC returns the strongly connected components of l, as scc.Run does.
+func SCC[K comparable, W datastructures.Number](l *datastructures.WeightedLabeledGraph[K, W]) [][]K {
+	return RunComponents(l, func(g datastructures.WeightedGraph[W]) [][]int {
+		return scc.Run(g)
+	})
+}
+
+// Path returns the keys on the path to dst that a search from a single source
+// found, starting at the source, or false if dst was not reached.
+func Path[K comparable](vertices map[K]Vertex[K], dst K) ([]K, bool) {
+	v, ok := vertices[dst]
+	if !ok || v.Color == graphs.White {
+		return nil, false
+	}
+	path := []K{v.Key}
+	for v.HasParent {
+		v = vertices[v.Parent]
+		path = append([]K{v.Key}, path...)
+	}
+	return path, true
+}
@@ -0,0 +1,70 @@
+package labeled
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/centrality"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func services(t *testing.T) *datastructures.LabeledGraph[string] {
+	t.Helper()
+	l, err := datastructures.NewLabeled[string](adjacencylist.New(datastructures.Options{}))
+	helpers.AssertEqual(t, err, nil)
+	l.AddEdge("web", "auth", 2)
+	l.AddEdge("web", "api", 1)
+	l.AddEdge("api", "auth", 4)
+	l.AddEdge("api", "db", 3)
+	l.AddEdge("auth", "db", 1)
+	l.AddEdge("db", "api", 5)
+	l.AddVertex("cache")
+	return l
+}
+
+func TestSearches(t *testing.T) {
+	l := services(t)
+
+	vertices, err := Dijkstra(l, "web")
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, vertices["db"].Dist, 3.0)
+	helpers.AssertEqual(t, vertices["db"].Parent, "auth")
+	helpers.Assert(t, !vertices["web"].HasParent)
+	path, ok := Path(vertices, "db")
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, helpers.ToString(path), "[web auth db]")
+	_, ok = Path(vertices, "cache")
+	helpers.Assert(t, !ok)
+
+	vertices, err = BFS(l, "api")
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, vertices["db"].Dist, 1.0)
+	helpers.AssertEqual(t, vertices["db"].Parent, "api")
+	_, ok = Path(vertices, "web")
+	helpers.Assert(t, !ok)
+
+	vertices, err = DFS(l, "web")
+	helpers.AssertEqual(t, err, nil)
+	path, ok = Path(vertices, "api")
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, helpers.ToString(path), "[web auth db api]")
+
+	_, err = Dijkstra(l, "mail")
+	helpers.Assert(t, err != nil)
+}
+
+func TestComponents(t *testing.T) {
+	l := services(t)
+	components := SCC(l)
+	for _, c := range components {
+		slices.Sort(c)
+	}
+	slices.SortFunc(components, func(a, b []string) int { return len(a) - len(b) })
+	helpers.AssertEqual(t, helpers.ToString(components[2]), "[api auth db]")
+	helpers.AssertEqual(t, len(components), 3)
+
+	scores := datastructures.ByKey(l, centrality.Closeness(l.Graph(), true))
+	helpers.AssertEqual(t, scores["cache"], 0.0)
+}
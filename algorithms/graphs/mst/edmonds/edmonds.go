@@ -0,0 +1,192 @@
+package edmonds
+
+import (
+	"fmt"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+// An arborescence rooted at r is a directed spanning tree in which every
+// vertex can be reached from r along exactly one path: every vertex other
+// than r has exactly one incoming edge.
+
+type Edge[W datastructures.Number] struct {
+	Src    int
+	Dst    int
+	Weight W
+}
+
+func (e Edge[W]) String() string {
+	return fmt.Sprintf("(%v --%v-> %v)", e.Src, e.Weight, e.Dst)
+}
+
+// Arborescence returns the edges of a minimum-weight arborescence of the
+// directed graph g rooted at root, and their total weight, using the
+// Chu-Liu/Edmonds algorithm in O(VE). It fails if some vertex cannot be
+// reached from root.
+//
+// Every vertex picks its cheapest incoming edge. If those edges form no cycle
+// they are the answer. Otherwise each cycle is contracted into a single
+// vertex, where an edge entering the cycle at v costs its weight minus that of
+// the edge it would replace, the smaller problem is solved, and the cycle is
+// expanded again without the replaced edge.
+func Arborescence[W datastructures.Number](g datastructures.WeightedGraph[W], root int) ([]Edge[W], W, error) {
+	if g.Undirected() {
+		return nil, 0, fmt.Errorf("error: graph is undirected")
+	}
+	if v := unreachable(g, root); v != -1 {
+		return nil, 0, fmt.Errorf("error: vertex %v cannot be reached from %v", v, root)
+	}
+	edges := []Edge[W]{}
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			if u != v && v != root {
+				w, _ := g.Weight(u, v)
+				edges = append(edges, Edge[W]{u, v, w})
+			}
+		}
+	}
+
+	chosen := solve(g.Size(), root, edges)
+	result := []Edge[W]{}
+	var total W
+	for _, i := range chosen {
+		result = append(result, edges[i])
+		total += edges[i].Weight
+	}
+	return result, total, nil
+}
+
+// unreachable returns the lowest vertex that cannot be reached from root, or
+// -1 if there is none.
+func unreachable(g datastructures.Topology, root int) int {
+	seen := make([]bool, g.Size())
+	seen[root] = true
+	stack := []int{root}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, v := range g.Neighbors(u) {
+			if !seen[v] {
+				seen[v] = true
+				stack = append(stack, v)
+			}
+		}
+	}
+	for v := range seen {
+		if !seen[v] {
+			return v
+		}
+	}
+	return -1
+}
+
+// solve returns the indices of the edges of a minimum arborescence over the
+// vertices 0..n-1, every one of which must be reachable from root. Contracting
+// a cycle keeps that true, so each vertex always has an incoming edge.
+func solve[W datastructures.Number](n, root int, edges []Edge[W]) []int {
+	in := make([]int, n) // index of the cheapest edge into each vertex
+	for v := range in {
+		in[v] = -1
+	}
+	for i, e := range edges {
+		if e.Src != e.Dst && e.Dst != root && (in[e.Dst] == -1 || e.Weight < edges[in[e.Dst]].Weight) {
+			in[e.Dst] = i
+		}
+	}
+
+	// follow the chosen edges backwards from every vertex to find cycles,
+	// numbering cycles first and then every other vertex
+	component := make([]int, n)
+	for v := range component {
+		component[v] = -1
+	}
+	visitedFrom := make([]int, n)
+	for v := range visitedFrom {
+		visitedFrom[v] = -1
+	}
+	cycles := 0
+	onCycle := make([]bool, n)
+	for s := 0; s < n; s++ {
+		v := s
+		for v != root && visitedFrom[v] == -1 && component[v] == -1 {
+			visitedFrom[v] = s
+			v = edges[in[v]].Src
+		}
+		if v != root && visitedFrom[v] == s && component[v] == -1 {
+			for u := v; component[u] == -1; u = edges[in[u]].Src {
+				component[u] = cycles
+				onCycle[u] = true
+			}
+			cycles++
+		}
+	}
+	if cycles == 0 {
+		result := []int{}
+		for v, i := range in {
+			if v != root {
+				result = append(result, i)
+			}
+		}
+		return result
+	}
+
+	k := cycles
+	for v := range component {
+		if component[v] == -1 {
+			component[v] = k
+			k++
+		}
+	}
+
+	// contract, remembering which edge each contracted edge came from
+	contracted := []Edge[W]{}
+	origin := []int{}
+	for i, e := range edges {
+		cu, cv := component[e.Src], component[e.Dst]
+		if cu == cv {
+			continue
+		}
+		w := e.Weight
+		if onCycle[e.Dst] {
+			w -= edges[in[e.Dst]].Weight
+		}
+		contracted = append(contracted, Edge[W]{cu, cv, w})
+		origin = append(origin, i)
+	}
+
+	chosen := solve(k, component[root], contracted)
+
+	result := []int{}
+	replaced := make([]bool, n) // cycle vertices whose incoming edge is replaced
+	for _, i := range chosen {
+		e := edges[origin[i]]
+		result = append(result, origin[i])
+		if onCycle[e.Dst] {
+			replaced[e.Dst] = true
+		}
+	}
+	for v := range in {
+		if onCycle[v] && !replaced[v] {
+			result = append(result, in[v])
+		}
+	}
+	return result
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 4,
+		Undirected:    false,
+	})
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(0, 2, 2)
+	g.AddEdge(0, 3, 10)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 4)
+	g.AddEdge(3, 1, 2)
+	g.AddEdge(2, 1, 8)
+
+	fmt.Println(Arborescence(g, 0))
+}
@@ -0,0 +1,151 @@
+package edmonds
+
+import (
+	"math/rand"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// assertArborescence checks that edges give every vertex but root one parent
+// and that following parents always leads back to root.
+func assertArborescence(t *testing.T, g datastructures.Graph, root int, edges []Edge[int], total int) {
+	t.Helper()
+	parent := make([]int, g.Size())
+	for v := range parent {
+		parent[v] = -1
+	}
+	sum := 0
+	for _, e := range edges {
+		w, ok := g.Weight(e.Src, e.Dst)
+		helpers.Assert(t, ok)
+		helpers.AssertEqual(t, w, e.Weight)
+		helpers.AssertEqual(t, parent[e.Dst], -1)
+		parent[e.Dst] = e.Src
+		sum += e.Weight
+	}
+	helpers.AssertEqual(t, sum, total)
+	helpers.AssertEqual(t, len(edges), g.Size()-1)
+	for v := range parent {
+		steps := 0
+		for u := v; u != root && steps <= g.Size(); u = parent[u] {
+			steps++
+		}
+		helpers.Assert(t, steps <= g.Size())
+	}
+}
+
+// bruteForce tries every choice of incoming edge for every vertex.
+func bruteForce(g datastructures.Graph, root int) (int, bool) {
+	n := g.Size()
+	incoming := make([][]int, n)
+	for u := 0; u < n; u++ {
+		for _, v := range g.Neighbors(u) {
+			if u != v && v != root {
+				incoming[v] = append(incoming[v], u)
+			}
+		}
+	}
+	parent := make([]int, n)
+	best, found := 0, false
+	var choose func(v int)
+	choose = func(v int) {
+		if v == n {
+			total := 0
+			for u := 0; u < n; u++ {
+				if u == root {
+					continue
+				}
+				steps := 0
+				for x := u; x != root; x = parent[x] {
+					if steps++; steps > n {
+						return
+					}
+				}
+				w, _ := g.Weight(parent[u], u)
+				total += w
+			}
+			if !found || total < best {
+				best, found = total, true
+			}
+			return
+		}
+		if v == root {
+			choose(v + 1)
+			return
+		}
+		for _, u := range incoming[v] {
+			parent[v] = u
+			choose(v + 1)
+		}
+	}
+	choose(0)
+	return best, found
+}
+
+func TestArborescence(t *testing.T) {
+	o := datastructures.Options{TotalVertices: 4}
+	for _, g := range []datastructures.Graph{adjacencylist.New(o), adjacencymatrix.New(o)} {
+		g.AddEdge(0, 1, 10)
+		g.AddEdge(0, 2, 2)
+		g.AddEdge(0, 3, 10)
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(2, 3, 4)
+		g.AddEdge(3, 1, 2)
+		g.AddEdge(2, 1, 8)
+
+		t.Run(g.Name(), func(t *testing.T) {
+			edges, total, err := Arborescence(g, 0)
+			helpers.AssertEqual(t, err, nil)
+			helpers.AssertEqual(t, total, 8)
+			assertArborescence(t, g, 0, edges, total)
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		g := adjacencylist.New(datastructures.Options{TotalVertices: 3})
+		g.AddEdge(0, 1, 1)
+		g.AddEdge(2, 1, 1)
+		_, _, err := Arborescence(g, 0)
+		helpers.Assert(t, err != nil)
+	})
+
+	t.Run("unreachable cycle", func(t *testing.T) {
+		// 1 and 2 each have an incoming edge, but only from each other
+		g := adjacencylist.New(datastructures.Options{TotalVertices: 4})
+		g.AddEdge(0, 3, 1)
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(2, 1, 1)
+		_, _, err := Arborescence(g, 0)
+		helpers.AssertEqual(t, err.Error(), "error: vertex 1 cannot be reached from 0")
+	})
+
+	t.Run("undirected", func(t *testing.T) {
+		g := adjacencylist.New(datastructures.Options{TotalVertices: 2, Undirected: true})
+		_, _, err := Arborescence(g, 0)
+		helpers.Assert(t, err != nil)
+	})
+
+	t.Run("random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 200; trial++ {
+			n := 1 + rng.Intn(6)
+			g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n)})
+			for i := 0; i < rng.Intn(3*n); i++ {
+				g.AddEdge(rng.Intn(n), rng.Intn(n), 1+rng.Intn(10))
+			}
+			root := rng.Intn(n)
+
+			want, ok := bruteForce(g, root)
+			edges, total, err := Arborescence(g, root)
+			helpers.AssertEqual(t, err == nil, ok)
+			if ok {
+				helpers.AssertEqual(t, total, want)
+				assertArborescence(t, g, root, edges, total)
+			}
+		}
+	})
+}
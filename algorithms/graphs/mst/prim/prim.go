@@ -1,7 +1,97 @@
 package prim
 
-import "github.com/mhrdini/godsa/datastructures/graphs"
+import (
+	"fmt"
+	"math"
 
-func MST(g graphs.Graph) {
+	"github.com/mhrdini/godsa/algorithms/graphs"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/trees/heaps/binaryheap"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+)
 
+type item struct {
+	vertex int
+	key    float64
+}
+
+func compareItems(a, b item) int {
+	return comparator.OrderedComparator(a.key, b.key)
+}
+
+// MST grows a minimum spanning tree of the undirected graph g from vertex 0.
+// Each vertex's Parent is its neighbour in the tree and Dist the weight of the
+// edge between them. If g is disconnected, a minimum spanning forest is grown
+// from the lowest vertex of each component.
+func MST[W datastructures.Number](g datastructures.WeightedGraph[W]) []*graphs.Vertex {
+	vertices := make([]*graphs.Vertex, g.Size())
+	for i := 0; i < g.Size(); i++ {
+		vertices[i] = &graphs.Vertex{Color: graphs.White, Value: i, Dist: math.Inf(1), Parent: nil}
+	}
+
+	for root := 0; root < g.Size(); root++ {
+		if vertices[root].Color != graphs.White {
+			continue
+		}
+		vertices[root].Dist = 0
+		h := binaryheap.MinHeap(compareItems, item{root, 0})
+		for !h.Empty() {
+			it, _ := h.Pop()
+			u := vertices[it.vertex]
+			if u.Color == graphs.Black {
+				continue
+			}
+			u.Color = graphs.Black
+			for _, v := range g.Neighbors(u.Value) {
+				neighbor := vertices[v]
+				if neighbor.Color == graphs.Black {
+					continue
+				}
+				w, _ := g.Weight(u.Value, v)
+				if float64(w) < neighbor.Dist {
+					neighbor.Color = graphs.Gray
+					neighbor.Dist = float64(w)
+					neighbor.Parent = u
+					h.Add(item{v, float64(w)})
+				}
+			}
+		}
+	}
+	return vertices
+}
+
+// Weight returns the total weight of the tree returned by MST.
+func Weight(vertices []*graphs.Vertex) float64 {
+	total := 0.0
+	for _, v := range vertices {
+		total += v.Dist
+	}
+	return total
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 9,
+		Undirected:    true,
+	})
+	// CLRS figure 23.1
+	g.AddEdge(0, 1, 4)
+	g.AddEdge(0, 7, 8)
+	g.AddEdge(1, 2, 8)
+	g.AddEdge(1, 7, 11)
+	g.AddEdge(2, 3, 7)
+	g.AddEdge(2, 5, 4)
+	g.AddEdge(2, 8, 2)
+	g.AddEdge(3, 4, 9)
+	g.AddEdge(3, 5, 14)
+	g.AddEdge(4, 5, 10)
+	g.AddEdge(5, 6, 2)
+	g.AddEdge(6, 7, 1)
+	g.AddEdge(6, 8, 6)
+	g.AddEdge(7, 8, 7)
+
+	vertices := MST(g)
+	fmt.Println(vertices)
+	fmt.Println("weight:", Weight(vertices))
 }
@@ -0,0 +1,49 @@
+package prim
+
+import (
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestMST(t *testing.T) {
+	o := datastructures.Options{TotalVertices: 9, Undirected: true}
+	for _, g := range []datastructures.Graph{adjacencylist.New(o), adjacencymatrix.New(o)} {
+		// CLRS figure 23.1
+		g.AddEdge(0, 1, 4)
+		g.AddEdge(0, 7, 8)
+		g.AddEdge(1, 2, 8)
+		g.AddEdge(1, 7, 11)
+		g.AddEdge(2, 3, 7)
+		g.AddEdge(2, 5, 4)
+		g.AddEdge(2, 8, 2)
+		g.AddEdge(3, 4, 9)
+		g.AddEdge(3, 5, 14)
+		g.AddEdge(4, 5, 10)
+		g.AddEdge(5, 6, 2)
+		g.AddEdge(6, 7, 1)
+		g.AddEdge(6, 8, 6)
+		g.AddEdge(7, 8, 7)
+
+		t.Run(g.Name(), func(t *testing.T) {
+			vertices := MST(g)
+			helpers.AssertEqual(t, Weight(vertices), 37.0)
+			helpers.AssertEqual(t, vertices[0].Parent, nil)
+			for _, v := range vertices[1:] {
+				helpers.Assert(t, v.Parent != nil)
+			}
+		})
+	}
+
+	t.Run("forest", func(t *testing.T) {
+		g := adjacencylist.New(datastructures.Options{TotalVertices: 4, Undirected: true})
+		g.AddEdge(0, 1, 3)
+		g.AddEdge(2, 3, 5)
+		vertices := MST(g)
+		helpers.AssertEqual(t, Weight(vertices), 8.0)
+		helpers.AssertEqual(t, vertices[2].Parent, nil)
+	})
+}
@@ -0,0 +1,188 @@
+package parallel
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"sync/atomic"
+
+	"github.com/mhrdini/godsa/algorithms/graphs"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+type Direction int
+
+const (
+	// Optimizing switches between top-down and bottom-up steps depending on
+	// how much of the graph the frontier covers.
+	Optimizing = Direction(iota)
+	// TopDown has every frontier vertex claim its unvisited successors.
+	TopDown
+	// BottomUp has every unvisited vertex look for a predecessor in the
+	// frontier.
+	BottomUp
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Optimizing:
+		return "optimizing"
+	case TopDown:
+		return "top-down"
+	case BottomUp:
+		return "bottom-up"
+	default:
+		return "error"
+	}
+}
+
+// Thresholds from Beamer et al., "Direction-Optimizing Breadth-First Search":
+// go bottom-up once the frontier has more than 1/alpha of the edges still to
+// be checked, and back top-down once it has fewer than 1/beta of the vertices.
+const (
+	alpha = 14
+	beta  = 24
+)
+
+const unclaimed = math.MaxUint64
+
+// BFS runs a level-synchronous breadth-first search from src and returns the
+// same vertices as bfs.Run: the distance and parent of every vertex reached
+// from src, and the distance +Inf and colour white for the rest.
+//
+// Every level, each vertex of the next frontier is claimed by the frontier
+// vertex that bfs.Run would have discovered it from: the earliest in the
+// frontier, and among its edges the earliest to the vertex. Claims are keyed
+// by (position of the parent in the frontier, position of the edge), the
+// smallest key wins, and the next frontier is sorted by key, which is exactly
+// the order in which bfs.Run enqueues it. This keeps the result independent
+// of the number of workers and of the direction of each step.
+func BFS(g datastructures.Topology, src int, o Options) []*graphs.Vertex {
+	s := newSnapshot(g)
+	n := s.size()
+	workers := o.workers()
+
+	level := make([]int, n)
+	parent := make([]int, n)
+	ranks := make([]int, n) // position of each frontier vertex in the frontier
+	keys := make([]atomic.Uint64, n)
+	for v := range level {
+		level[v] = -1
+		parent[v] = -1
+		keys[v].Store(unclaimed)
+	}
+	level[src] = 0
+
+	frontier := []int{src}
+	unexplored := len(s.targets) - s.degree(src) // edges out of unvisited vertices
+	bottomUp := o.Direction == BottomUp
+	locals := make([][]int, workers)
+	for depth := 0; len(frontier) > 0; depth++ {
+		if o.Direction == Optimizing {
+			scout := 0
+			for _, u := range frontier {
+				scout += s.degree(u)
+			}
+			if !bottomUp && scout > unexplored/alpha {
+				bottomUp = true
+			} else if bottomUp && len(frontier) < n/beta {
+				bottomUp = false
+			}
+		}
+
+		for w := range locals {
+			locals[w] = locals[w][:0]
+		}
+		if bottomUp {
+			forEach(n, workers, func(w, v int) {
+				if level[v] != -1 {
+					return
+				}
+				best := uint64(unclaimed)
+				for i := s.inOffsets[v]; i < s.inOffsets[v+1]; i++ {
+					if u := s.sources[i]; level[u] == depth {
+						best = min(best, key(ranks[u], s.positions[i]))
+					}
+				}
+				if best != unclaimed {
+					keys[v].Store(best)
+					locals[w] = append(locals[w], v)
+				}
+			})
+		} else {
+			forEach(len(frontier), workers, func(w, r int) {
+				u := frontier[r]
+				for i := s.offsets[u]; i < s.offsets[u+1]; i++ {
+					v := s.targets[i]
+					if level[v] != -1 {
+						continue
+					}
+					k := key(r, i-s.offsets[u])
+					for old := keys[v].Load(); k < old; old = keys[v].Load() {
+						if keys[v].CompareAndSwap(old, k) {
+							if old == unclaimed {
+								locals[w] = append(locals[w], v)
+							}
+							break
+						}
+					}
+				}
+			})
+		}
+
+		next := slices.Concat(locals...)
+		slices.SortFunc(next, func(a, b int) int {
+			return cmp.Compare(keys[a].Load(), keys[b].Load())
+		})
+		for r, v := range next {
+			ranks[v] = r
+			level[v] = depth + 1
+			parent[v] = frontier[keys[v].Load()>>32]
+			unexplored -= s.degree(v)
+		}
+		frontier = next
+	}
+
+	vertices := make([]*graphs.Vertex, n)
+	for v := range vertices {
+		vertices[v] = &graphs.Vertex{Color: graphs.White, Value: v, Dist: math.Inf(1)}
+		if level[v] != -1 {
+			vertices[v].Color = graphs.Black
+			vertices[v].Dist = float64(level[v])
+		}
+	}
+	for v, p := range parent {
+		if p != -1 {
+			vertices[v].Parent = vertices[p]
+		}
+	}
+	return vertices
+}
+
+// key packs the position of a parent in the frontier and of the edge among
+// its successors so that keys compare lexicographically.
+func key(rank, position int) uint64 {
+	return uint64(rank)<<32 | uint64(position)
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 8,
+		Undirected:    false,
+	})
+	// CP3 4.4 DAG in visualgo.net
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(2, 5, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(7, 6, 1)
+
+	for _, v := range BFS(g, 0, Options{Workers: 4}) {
+		fmt.Println(v)
+	}
+}
@@ -0,0 +1,136 @@
+package parallel
+
+import (
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+// Afforest parameters from Sutton et al., "Optimizing Parallel Graph
+// Connectivity Computation via Subgraph Sampling": link along the first few
+// edges of every vertex, then guess the largest component from a sample.
+const (
+	neighborRounds = 2
+	samples        = 1024
+)
+
+// Components returns the connected components of g, treating directed edges
+// as undirected, by labelling every vertex with the smallest vertex in its
+// component.
+//
+// It uses Afforest. Every component is a tree of vertices, each pointing
+// towards a smaller one, whose root is the smallest vertex; trees are joined
+// by pointing the larger root at the smaller with a compare-and-swap, and
+// flattened by pointer jumping. Linking along only the first few edges of
+// every vertex usually puts most of the graph into one component already, and
+// vertices in that component skip the rest of their edges.
+func Components(g datastructures.Topology, o Options) []int {
+	s := newSnapshot(g)
+	n := s.size()
+	workers := o.workers()
+
+	comp := make([]atomic.Int64, n)
+	for v := range comp {
+		comp[v].Store(int64(v))
+	}
+
+	for r := 0; r < neighborRounds; r++ {
+		forEach(n, workers, func(_, v int) {
+			if r < s.degree(v) {
+				link(comp, v, s.targets[s.offsets[v]+r])
+			}
+		})
+		compress(comp, workers)
+	}
+
+	largest := sampleLargest(comp)
+	forEach(n, workers, func(_, v int) {
+		if comp[v].Load() == largest {
+			return
+		}
+		for i := s.offsets[v] + neighborRounds; i < s.offsets[v+1]; i++ {
+			link(comp, v, s.targets[i])
+		}
+		// an edge from the largest component to v was skipped above, so
+		// directed graphs also have to follow edges backwards
+		if !s.undirected {
+			for i := s.inOffsets[v]; i < s.inOffsets[v+1]; i++ {
+				link(comp, v, s.sources[i])
+			}
+		}
+	})
+	compress(comp, workers)
+
+	labels := make([]int, n)
+	for v := range labels {
+		labels[v] = int(comp[v].Load())
+	}
+	return labels
+}
+
+// link joins the trees of u and v.
+func link(comp []atomic.Int64, u, v int) {
+	p1, p2 := comp[u].Load(), comp[v].Load()
+	for p1 != p2 {
+		high, low := max(p1, p2), min(p1, p2)
+		pHigh := comp[high].Load()
+		if pHigh == low {
+			return
+		}
+		if pHigh == high && comp[high].CompareAndSwap(high, low) {
+			return
+		}
+		p1, p2 = comp[comp[high].Load()].Load(), comp[low].Load()
+	}
+}
+
+// compress points every vertex straight at the root of its tree.
+func compress(comp []atomic.Int64, workers int) {
+	forEach(len(comp), workers, func(_, v int) {
+		for {
+			p := comp[v].Load()
+			gp := comp[p].Load()
+			if p == gp {
+				return
+			}
+			comp[v].Store(gp)
+		}
+	})
+}
+
+// sampleLargest returns the most frequent label among a fixed sample of
+// vertices.
+func sampleLargest(comp []atomic.Int64) int64 {
+	if len(comp) == 0 {
+		return -1
+	}
+	rng := rand.New(rand.NewSource(1))
+	counts := map[int64]int{}
+	largest := comp[0].Load()
+	for i := 0; i < samples; i++ {
+		c := comp[rng.Intn(len(comp))].Load()
+		counts[c]++
+		if counts[c] > counts[largest] {
+			largest = c
+		}
+	}
+	return largest
+}
+
+func DemoComponents() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 8,
+		Undirected:    true,
+	})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(5, 6, 1)
+	g.AddEdge(6, 7, 1)
+	g.AddEdge(7, 5, 1)
+
+	fmt.Println(Components(g, Options{Workers: 4}))
+}
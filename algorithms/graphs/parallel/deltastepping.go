@@ -0,0 +1,199 @@
+package parallel
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"github.com/mhrdini/godsa/algorithms/graphs"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+// DeltaStepping returns every vertex with its distance from src and its
+// parent on a shortest path, like dijkstra.Run; the edge weights must not be
+// negative. Unreachable vertices stay white with an infinite distance.
+// Distances are summed in float64, as dijkstra.Run sums them, so integer
+// weights are exact as long as every distance is below 2^53.
+//
+// Vertices are kept in buckets of width delta by tentative distance, vertex v
+// in bucket int(dist(v)/delta), so fractional weights and deltas work alike.
+// The lowest non-empty bucket is settled by relaxing the light edges, those no
+// heavier than delta, of all its vertices in parallel until it stays empty,
+// since they can put vertices back into it; then the heavy edges of every
+// vertex settled from it are relaxed once. A small delta approaches
+// Dijkstra's algorithm, a large one Bellman-Ford.
+//
+// Parents are chosen after the distances are known, by a breadth-first search
+// from src over the edges that lie on shortest paths, so that zero-weight
+// cycles cannot produce parent cycles.
+func DeltaStepping[W datastructures.Number](g datastructures.WeightedGraph[W], src int, o Options) []*graphs.Vertex {
+	s := newSnapshot(g)
+	weigh(s, g)
+	n := s.size()
+	workers := o.workers()
+	delta := o.Delta
+	if delta <= 0 {
+		delta = s.delta()
+	}
+
+	dist := make([]distance, n)
+	for v := range dist {
+		dist[v].store(math.Inf(1))
+	}
+	dist[src].store(0)
+	bucket := func(v int) int {
+		return int(dist[v].load() / delta)
+	}
+
+	// relax lowers the distances of the successors of the vertices in
+	// frontier along the edges accepted by use, and returns the vertices
+	// whose distance went down
+	locals := make([][]int, workers)
+	relax := func(frontier []int, use func(w float64) bool) [][]int {
+		for w := range locals {
+			locals[w] = locals[w][:0]
+		}
+		forEach(len(frontier), workers, func(worker, r int) {
+			u := frontier[r]
+			du := dist[u].load()
+			for i := s.offsets[u]; i < s.offsets[u+1]; i++ {
+				w := s.weights[i]
+				if !use(w) {
+					continue
+				}
+				v := s.targets[i]
+				for old := dist[v].load(); du+w < old; old = dist[v].load() {
+					if dist[v].compareAndSwap(old, du+w) {
+						locals[worker] = append(locals[worker], v)
+						break
+					}
+				}
+			}
+		})
+		return locals
+	}
+
+	buckets := [][]int{{src}}
+	add := func(updated [][]int) {
+		for _, vs := range updated {
+			for _, v := range vs {
+				b := bucket(v)
+				for len(buckets) <= b {
+					buckets = append(buckets, nil)
+				}
+				buckets[b] = append(buckets[b], v)
+			}
+		}
+	}
+
+	stamp := make([]int, n) // last round a vertex was taken in
+	settled := make([]bool, n)
+	round := 0
+	for i := 0; i < len(buckets); i++ {
+		removed := []int{}
+		for len(buckets[i]) > 0 {
+			// drop entries left behind by a later decrease, and duplicates
+			frontier := []int{}
+			round++
+			for _, v := range buckets[i] {
+				if bucket(v) == i && stamp[v] != round {
+					stamp[v] = round
+					frontier = append(frontier, v)
+				}
+			}
+			buckets[i] = nil
+			for _, v := range frontier {
+				if !settled[v] {
+					settled[v] = true
+					removed = append(removed, v)
+				}
+			}
+			add(relax(frontier, func(w float64) bool { return w <= delta }))
+		}
+		add(relax(removed, func(w float64) bool { return w > delta }))
+	}
+
+	return s.shortestPathTree(src, dist)
+}
+
+// distance is a tentative distance that workers can lower concurrently. It
+// holds the bits of a float64, since there is no atomic float64.
+type distance struct {
+	bits atomic.Uint64
+}
+
+func (d *distance) load() float64 {
+	return math.Float64frombits(d.bits.Load())
+}
+
+func (d *distance) store(x float64) {
+	d.bits.Store(math.Float64bits(x))
+}
+
+func (d *distance) compareAndSwap(old, new float64) bool {
+	return d.bits.CompareAndSwap(math.Float64bits(old), math.Float64bits(new))
+}
+
+// delta returns the largest edge weight divided by the average degree, which
+// keeps the expected number of light edges per vertex constant.
+func (s *snapshot) delta() float64 {
+	heaviest := 0.0
+	for _, w := range s.weights {
+		heaviest = max(heaviest, w)
+	}
+	degree := float64(max(1, len(s.targets)/max(1, s.size())))
+	if heaviest == 0 {
+		return 1
+	}
+	return heaviest / degree
+}
+
+func (s *snapshot) shortestPathTree(src int, dist []distance) []*graphs.Vertex {
+	n := s.size()
+	vertices := make([]*graphs.Vertex, n)
+	for v := range vertices {
+		vertices[v] = &graphs.Vertex{Color: graphs.White, Value: v, Dist: math.Inf(1)}
+	}
+	vertices[src].Color = graphs.Black
+	vertices[src].Dist = 0
+
+	queue := []int{src}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		du := dist[u].load()
+		for i := s.offsets[u]; i < s.offsets[u+1]; i++ {
+			v := s.targets[i]
+			if vertices[v].Color == graphs.White && du+s.weights[i] == dist[v].load() {
+				vertices[v].Color = graphs.Black
+				vertices[v].Dist = dist[v].load()
+				vertices[v].Parent = vertices[u]
+				queue = append(queue, v)
+			}
+		}
+	}
+	return vertices
+}
+
+func DemoDeltaStepping() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 5,
+		Undirected:    false,
+	})
+	// CLRS 24.6
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(0, 3, 5)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 3, 2)
+	g.AddEdge(2, 4, 4)
+	g.AddEdge(3, 1, 3)
+	g.AddEdge(3, 2, 9)
+	g.AddEdge(3, 4, 2)
+	g.AddEdge(4, 0, 7)
+	g.AddEdge(4, 2, 6)
+
+	for _, v := range DeltaStepping(g, 0, Options{Workers: 4}) {
+		fmt.Println(v)
+	}
+}
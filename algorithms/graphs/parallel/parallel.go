@@ -0,0 +1,121 @@
+package parallel
+
+import (
+	"runtime"
+	"sync"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// The algorithms in this package split their work across goroutines. Each
+// reads the graph once into a snapshot of flat adjacency slices, since
+// datastructures.Graph makes no promises about concurrent reads, and then
+// works on the snapshot alone.
+
+type Options struct {
+	// Workers is the number of goroutines to use. 0 or less means
+	// runtime.GOMAXPROCS(0).
+	Workers int
+	// Direction selects the BFS strategy.
+	Direction Direction
+	// Delta is the bucket width of DeltaStepping. 0 or less picks one from
+	// the edge weights and degrees of the graph.
+	Delta float64
+}
+
+func (o Options) workers() int {
+	if o.Workers > 0 {
+		return o.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// snapshot stores the successors of u as targets[offsets[u]:offsets[u+1]] and
+// the predecessors of v as sources[inOffsets[v]:inOffsets[v+1]], in the order
+// Neighbors returns them. positions[i] is the index of the edge
+// sources[i] -> v within the successors of sources[i].
+type snapshot struct {
+	undirected bool
+
+	offsets   []int
+	targets   []int
+	weights   []float64
+	inOffsets []int
+	sources   []int
+	positions []int
+}
+
+func newSnapshot(g datastructures.Topology) *snapshot {
+	n := g.Size()
+	s := &snapshot{
+		undirected: g.Undirected(),
+		offsets:    make([]int, n+1),
+		inOffsets:  make([]int, n+1),
+	}
+	for u := 0; u < n; u++ {
+		for _, v := range g.Neighbors(u) {
+			s.targets = append(s.targets, v)
+			s.inOffsets[v+1]++
+		}
+		s.offsets[u+1] = len(s.targets)
+	}
+
+	for v := 0; v < n; v++ {
+		s.inOffsets[v+1] += s.inOffsets[v]
+	}
+	s.sources = make([]int, len(s.targets))
+	s.positions = make([]int, len(s.targets))
+	next := append([]int{}, s.inOffsets[:n]...)
+	for u := 0; u < n; u++ {
+		for i := s.offsets[u]; i < s.offsets[u+1]; i++ {
+			v := s.targets[i]
+			s.sources[next[v]] = u
+			s.positions[next[v]] = i - s.offsets[u]
+			next[v]++
+		}
+	}
+	return s
+}
+
+// weigh fills in the weight of every edge, weights[i] being the weight of the
+// edge to targets[i].
+func weigh[W datastructures.Number](s *snapshot, g datastructures.WeightedGraph[W]) {
+	s.weights = make([]float64, len(s.targets))
+	for u := 0; u < s.size(); u++ {
+		for i := s.offsets[u]; i < s.offsets[u+1]; i++ {
+			w, _ := g.Weight(u, s.targets[i])
+			s.weights[i] = float64(w)
+		}
+	}
+}
+
+func (s *snapshot) size() int {
+	return len(s.offsets) - 1
+}
+
+func (s *snapshot) degree(u int) int {
+	return s.offsets[u+1] - s.offsets[u]
+}
+
+// forEach calls f on every index in [0, n) from the given number of
+// goroutines, each taking a contiguous block of indices, and waits for them
+// all. f receives the number of the goroutine calling it.
+func forEach(n, workers int, f func(worker, i int)) {
+	workers = max(1, min(workers, n))
+	block := (n + workers - 1) / max(1, workers)
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		from, to := w*block, min(n, (w+1)*block)
+		if from >= to {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := from; i < to; i++ {
+				f(w, i)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,188 @@
+package parallel
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs"
+	"github.com/mhrdini/godsa/algorithms/graphs/bfs"
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func random(rng *rand.Rand, n, m int, undirected bool) datastructures.Graph {
+	g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n), Undirected: undirected})
+	for i := 0; i < m; i++ {
+		g.AddEdge(rng.Intn(n), rng.Intn(n), 1+rng.Intn(20))
+	}
+	return g
+}
+
+func parentOf(v *graphs.Vertex) int {
+	if v.Parent == nil {
+		return -1
+	}
+	return v.Parent.Value
+}
+
+func assertSameVertices(t *testing.T, got, want []*graphs.Vertex) {
+	t.Helper()
+	helpers.AssertEqual(t, len(got), len(want))
+	for v := range want {
+		helpers.AssertEqual(t, got[v].Value, want[v].Value)
+		helpers.AssertEqual(t, got[v].Dist, want[v].Dist)
+		helpers.AssertEqual(t, parentOf(got[v]), parentOf(want[v]))
+		helpers.AssertEqual(t, got[v].Color, want[v].Color)
+	}
+}
+
+func TestBFS(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	testCases := []struct {
+		desc       string
+		n, m       int
+		undirected bool
+	}{
+		{"sparse directed", 300, 600, false},
+		{"sparse undirected", 300, 400, true},
+		{"dense directed", 200, 8000, false},
+		{"dense undirected", 200, 4000, true},
+		{"disconnected", 300, 100, true},
+		{"single vertex", 1, 0, false},
+	}
+
+	for _, tc := range testCases {
+		g := random(rng, tc.n, tc.m, tc.undirected)
+		src := rng.Intn(tc.n)
+		want := bfs.Run(g, src)
+		for _, d := range []Direction{Optimizing, TopDown, BottomUp} {
+			for _, workers := range []int{0, 1, 3, 8} {
+				t.Run(fmt.Sprintf("%v %v %v workers", tc.desc, d, workers), func(t *testing.T) {
+					assertSameVertices(t, BFS(g, src, Options{Workers: workers, Direction: d}), want)
+				})
+			}
+		}
+	}
+}
+
+// components labels every vertex with the smallest vertex in its component
+// using a sequential union-find.
+func components(g datastructures.Graph) []int {
+	parent := make([]int, g.Size())
+	for v := range parent {
+		parent[v] = v
+	}
+	var find func(v int) int
+	find = func(v int) int {
+		if parent[v] != v {
+			parent[v] = find(parent[v])
+		}
+		return parent[v]
+	}
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			a, b := find(u), find(v)
+			parent[max(a, b)] = min(a, b)
+		}
+	}
+	for v := range parent {
+		parent[v] = find(v)
+	}
+	return parent
+}
+
+func TestComponents(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	testCases := []struct {
+		desc       string
+		n, m       int
+		undirected bool
+	}{
+		{"connected", 500, 2000, true},
+		{"fragmented", 500, 200, true},
+		{"directed", 500, 300, false},
+		{"giant component", 3000, 3000, false},
+		{"no edges", 10, 0, false},
+		{"empty", 0, 0, true},
+	}
+
+	for _, tc := range testCases {
+		g := random(rng, max(tc.n, 1), tc.m, tc.undirected)
+		if tc.n == 0 {
+			g = adjacencylist.New(datastructures.Options{Undirected: true})
+		}
+		want := helpers.ToString(components(g))
+		for _, workers := range []int{0, 1, 3, 8} {
+			t.Run(fmt.Sprintf("%v %v workers", tc.desc, workers), func(t *testing.T) {
+				helpers.AssertEqual(t, helpers.ToString(Components(g, Options{Workers: workers})), want)
+			})
+		}
+	}
+}
+
+func TestDeltaStepping(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	testCases := []struct {
+		desc       string
+		n, m       int
+		undirected bool
+	}{
+		{"sparse directed", 300, 900, false},
+		{"dense undirected", 150, 3000, true},
+		{"disconnected", 300, 150, true},
+	}
+
+	for _, tc := range testCases {
+		g := random(rng, tc.n, tc.m, tc.undirected)
+		src := rng.Intn(tc.n)
+		want := dijkstra.Run(g, src)
+		for _, delta := range []float64{0, 0.5, 1, 5, 100} {
+			for _, workers := range []int{0, 1, 3, 8} {
+				t.Run(fmt.Sprintf("%v delta %v %v workers", tc.desc, delta, workers), func(t *testing.T) {
+					got := DeltaStepping(g, src, Options{Workers: workers, Delta: delta})
+					for v := range want {
+						helpers.AssertEqual(t, got[v].Dist, want[v].Dist)
+						helpers.AssertEqual(t, got[v].Color, want[v].Color)
+						if p := got[v].Parent; p != nil {
+							w, ok := g.Weight(p.Value, v)
+							helpers.Assert(t, ok)
+							helpers.AssertEqual(t, p.Dist+float64(w), got[v].Dist)
+						} else {
+							helpers.Assert(t, v == src || math.IsInf(got[v].Dist, 1))
+						}
+					}
+				})
+			}
+		}
+	}
+
+	t.Run("fractional weights", func(t *testing.T) {
+		// eighths add up exactly, so the distances match dijkstra.Run's
+		g := adjacencylist.NewWeighted[float64](datastructures.Options{TotalVertices: 200})
+		for i := 0; i < 1000; i++ {
+			g.AddEdge(rng.Intn(200), rng.Intn(200), float64(1+rng.Intn(40))/8)
+		}
+		want := dijkstra.Run(g, 0)
+		for _, delta := range []float64{0, 0.25, 1.5} {
+			got := DeltaStepping(g, 0, Options{Workers: 4, Delta: delta})
+			for v := range want {
+				helpers.AssertEqual(t, got[v].Dist, want[v].Dist)
+				helpers.AssertEqual(t, got[v].Color, want[v].Color)
+			}
+		}
+	})
+
+	t.Run("zero-weight cycle", func(t *testing.T) {
+		g := adjacencylist.New(datastructures.Options{TotalVertices: 3})
+		g.AddEdge(0, 1, 0)
+		g.AddEdge(1, 2, 0)
+		g.AddEdge(2, 1, 0)
+		got := DeltaStepping(g, 0, Options{Workers: 2})
+		helpers.AssertEqual(t, parentOf(got[1]), 0)
+		helpers.AssertEqual(t, parentOf(got[2]), 1)
+	})
+}
@@ -0,0 +1,96 @@
+package paths
+
+import (
+	"fmt"
+	"iter"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+// Path is a walk through a graph and its total edge weight.
+type Path struct {
+	Vertices []int
+	Cost     float64
+}
+
+func (p Path) String() string {
+	return fmt.Sprintf("%v (%v)", p.Vertices, p.Cost)
+}
+
+// cost returns the total weight of the edges along vertices.
+func cost[W datastructures.Number](g datastructures.WeightedGraph[W], vertices []int) float64 {
+	total := 0.0
+	for i := 1; i < len(vertices); i++ {
+		w, _ := g.Weight(vertices[i-1], vertices[i])
+		total += float64(w)
+	}
+	return total
+}
+
+// SimplePaths yields every path from src to dst that repeats no vertex and has
+// at most maxDepth edges, in depth-first order. A maxDepth of 0 or less means
+// no limit. The number of simple paths can grow exponentially, so callers
+// should stop once they have enough.
+func SimplePaths[W datastructures.Number](g datastructures.WeightedGraph[W], src, dst, maxDepth int) iter.Seq[Path] {
+	return func(yield func(Path) bool) {
+		onPath := make([]bool, g.Size())
+		path := []int{src}
+		onPath[src] = true
+
+		// visit returns false once the caller has stopped
+		var visit func(u int, c float64) bool
+		visit = func(u int, c float64) bool {
+			if u == dst {
+				return yield(Path{append([]int{}, path...), c})
+			}
+			if maxDepth > 0 && len(path)-1 == maxDepth {
+				return true
+			}
+			for _, v := range g.Neighbors(u) {
+				if onPath[v] {
+					continue
+				}
+				w, _ := g.Weight(u, v)
+				onPath[v] = true
+				path = append(path, v)
+				ok := visit(v, c+float64(w))
+				path = path[:len(path)-1]
+				onPath[v] = false
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		visit(src, 0)
+	}
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 6,
+		Undirected:    false,
+	})
+	// C, D, E, F, G, H from the example on Wikipedia's page on Yen's algorithm
+	g.AddEdge(0, 1, 3)
+	g.AddEdge(0, 2, 2)
+	g.AddEdge(1, 3, 4)
+	g.AddEdge(2, 1, 1)
+	g.AddEdge(2, 3, 2)
+	g.AddEdge(2, 4, 3)
+	g.AddEdge(3, 4, 2)
+	g.AddEdge(3, 5, 1)
+	g.AddEdge(4, 5, 2)
+
+	k := 0
+	for p := range KShortest(g, 0, 5) {
+		fmt.Println(p)
+		if k++; k == 3 {
+			break
+		}
+	}
+	for p := range SimplePaths(g, 0, 5, 3) {
+		fmt.Println(p)
+	}
+}
@@ -0,0 +1,99 @@
+package paths
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/datastructures/utils/sorter"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// C, D, E, F, G, H from the example on Wikipedia's page on Yen's algorithm
+var yen = []graphtest.Edge{
+	{0, 1, 3}, {0, 2, 2}, {1, 3, 4}, {2, 1, 1}, {2, 3, 2},
+	{2, 4, 3}, {3, 4, 2}, {3, 5, 1}, {4, 5, 2},
+}
+
+func take(paths func(func(Path) bool), k int) []Path {
+	result := []Path{}
+	for p := range paths {
+		result = append(result, p)
+		if len(result) == k {
+			break
+		}
+	}
+	return result
+}
+
+func TestKShortest(t *testing.T) {
+	for _, g := range graphtest.BuildEach(6, false, yen) {
+		t.Run(g.Name(), func(t *testing.T) {
+			got := take(KShortest(g, 0, 5), 4)
+			want := "[[0 2 3 5] (5) [0 2 4 5] (7) [0 1 3 5] (8) [0 2 1 3 5] (8)]"
+			helpers.AssertEqual(t, fmt.Sprint(got), want)
+		})
+	}
+
+	t.Run("exhausts every loopless path", func(t *testing.T) {
+		g := graphtest.Build(6, false, yen)
+		helpers.AssertEqual(t, len(take(KShortest(g, 0, 5), 100)), len(take(SimplePaths(g, 0, 5, 0), 100)))
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		g := graphtest.Build(6, false, yen)
+		helpers.AssertEqual(t, len(take(KShortest(g, 5, 0), 10)), 0)
+	})
+
+	t.Run("matches sorted simple paths on random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 30; trial++ {
+			n := 2 + rng.Intn(7)
+			undirected := rng.Intn(2) == 0
+			edges := []graphtest.Edge{}
+			for i := 0; i < 2*n; i++ {
+				edges = append(edges, graphtest.Edge{rng.Intn(n), rng.Intn(n), 1 + rng.Intn(5)})
+			}
+			g := graphtest.Build(n, undirected, edges)
+			all := take(SimplePaths(g, 0, n-1, 0), 0)
+			sorter.Sort(all, comparePaths)
+
+			got := take(KShortest(g, 0, n-1), 0)
+			helpers.AssertEqual(t, len(got), len(all))
+			for i := range got {
+				helpers.AssertEqual(t, got[i].Cost, all[i].Cost)
+			}
+		}
+	})
+}
+
+func TestSimplePaths(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		maxDepth int
+		want     string
+	}{
+		{"unlimited", 0, "[[0 1 3 4 5] (11) [0 1 3 5] (8) [0 2 1 3 4 5] (11) [0 2 1 3 5] (8) [0 2 3 4 5] (8) [0 2 3 5] (5) [0 2 4 5] (7)]"},
+		{"three edges", 3, "[[0 1 3 5] (8) [0 2 3 5] (5) [0 2 4 5] (7)]"},
+		{"one edge", 1, "[]"},
+	}
+
+	for _, tc := range testCases {
+		for _, g := range graphtest.BuildEach(6, false, yen) {
+			t.Run(fmt.Sprintf("%v on %v", tc.desc, g.Name()), func(t *testing.T) {
+				helpers.AssertEqual(t, fmt.Sprint(take(SimplePaths(g, 0, 5, tc.maxDepth), 0)), tc.want)
+			})
+		}
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		g := graphtest.Build(6, false, yen)
+		helpers.AssertEqual(t, len(take(SimplePaths(g, 0, 5, 0), 2)), 2)
+	})
+
+	t.Run("undirected", func(t *testing.T) {
+		g := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {0, 2, 1}})
+		helpers.AssertEqual(t, fmt.Sprint(take(SimplePaths(g, 0, 2, 0), 0)), "[[0 1 2] (2) [0 2] (1)]")
+	})
+}
@@ -0,0 +1,91 @@
+package paths
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/trees/heaps/binaryheap"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+)
+
+// comparePaths orders paths by cost, then lexicographically by vertices so
+// that ties are broken deterministically.
+func comparePaths(a, b Path) int {
+	if c := comparator.OrderedComparator(a.Cost, b.Cost); c != comparator.Equal {
+		return c
+	}
+	for i := 0; i < len(a.Vertices) && i < len(b.Vertices); i++ {
+		if c := comparator.OrderedComparator(a.Vertices[i], b.Vertices[i]); c != comparator.Equal {
+			return c
+		}
+	}
+	return comparator.OrderedComparator(len(a.Vertices), len(b.Vertices))
+}
+
+// KShortest yields the loopless paths from src to dst in order of increasing
+// cost using Yen's algorithm, until there are none left or the caller stops.
+// Edge weights must be non-negative.
+//
+// Each new path deviates from a previous one at some spur vertex: the prefix
+// up to the spur is kept, and the rest is the shortest path from the spur that
+// avoids the prefix and every edge already used at that point by a path
+// sharing the prefix. The cheapest candidate not yet yielded comes next.
+func KShortest[W datastructures.Number](g datastructures.WeightedGraph[W], src, dst int) iter.Seq[Path] {
+	return func(yield func(Path) bool) {
+		vertices := dijkstra.Run(g, src)
+		first, ok := dijkstra.Path(vertices, dst)
+		if !ok {
+			return
+		}
+
+		found := []Path{{first, vertices[dst].Dist}}
+		seen := map[string]bool{fmt.Sprint(first): true}
+		candidates := binaryheap.MinHeap(comparePaths)
+		if !yield(found[0]) {
+			return
+		}
+
+		for {
+			prev := found[len(found)-1].Vertices
+			for i := 0; i < len(prev)-1; i++ {
+				spur, root := prev[i], prev[:i+1]
+
+				blockedVertices := map[int]bool{}
+				for _, v := range root[:i] {
+					blockedVertices[v] = true
+				}
+				blockedEdges := map[[2]int]bool{}
+				for _, p := range found {
+					if len(p.Vertices) > i+1 && slices.Equal(p.Vertices[:i+1], root) {
+						blockedEdges[[2]int{p.Vertices[i], p.Vertices[i+1]}] = true
+					}
+				}
+
+				spurVertices := dijkstra.RunFiltered(g, spur, func(u, v int) bool {
+					return !blockedVertices[v] && !blockedEdges[[2]int{u, v}]
+				})
+				spurPath, ok := dijkstra.Path(spurVertices, dst)
+				if !ok {
+					continue
+				}
+				total := append(append([]int{}, root...), spurPath[1:]...)
+				if key := fmt.Sprint(total); !seen[key] {
+					seen[key] = true
+					candidates.Add(Path{total, cost(g, root) + spurVertices[dst].Dist})
+				}
+			}
+
+			next, ok := candidates.Pop()
+			if !ok {
+				return
+			}
+			found = append(found, next)
+			if !yield(next) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package rootedtree
+
+import "github.com/mhrdini/godsa/datastructures/stacks/linkedliststack"
+
+// Segment is an inclusive range of positions in a heavy-light decomposition.
+type Segment struct {
+	From int
+	To   int
+}
+
+// HeavyLight decomposes the tree into vertex-disjoint heavy paths, so that any
+// path in the tree crosses O(log n) of them. Vertices are numbered in a DFS
+// that visits the heavy child first, which makes every heavy path and every
+// subtree a contiguous range of positions. Pair it with a segment tree or
+// Fenwick tree over those positions for path and subtree queries.
+type HeavyLight struct {
+	tree *Tree
+	size []int
+	head []int // topmost vertex of the heavy path containing each vertex
+	pos  []int // position of each vertex in the decomposition
+}
+
+func NewHeavyLight(t *Tree) *HeavyLight {
+	n := t.Size()
+	h := &HeavyLight{
+		tree: t,
+		size: t.SubtreeSizes(),
+		head: make([]int, n),
+		pos:  make([]int, n),
+	}
+	if n == 0 {
+		return h
+	}
+
+	h.head[t.root] = t.root
+	next := 0
+	s := linkedliststack.New(t.root)
+	for v, ok := s.Pop(); ok; v, ok = s.Pop() {
+		h.pos[v] = next
+		next++
+
+		heavy := -1
+		for _, c := range t.children[v] {
+			if heavy == -1 || h.size[c] > h.size[heavy] {
+				heavy = c
+			}
+		}
+		for i := len(t.children[v]) - 1; i >= 0; i-- {
+			if c := t.children[v][i]; c != heavy {
+				h.head[c] = c
+				s.Push(c)
+			}
+		}
+		if heavy != -1 {
+			h.head[heavy] = h.head[v]
+			s.Push(heavy)
+		}
+	}
+	return h
+}
+
+// Pos returns the position of v in the decomposition.
+func (h *HeavyLight) Pos(v int) int {
+	return h.pos[v]
+}
+
+// Head returns the topmost vertex of the heavy path containing v.
+func (h *HeavyLight) Head(v int) int {
+	return h.head[v]
+}
+
+func (h *HeavyLight) LCA(u, v int) int {
+	t := h.tree
+	for h.head[u] != h.head[v] {
+		if t.depth[h.head[u]] < t.depth[h.head[v]] {
+			u, v = v, u
+		}
+		u = t.parent[h.head[u]]
+	}
+	if t.depth[u] < t.depth[v] {
+		return u
+	}
+	return v
+}
+
+// Path returns the segments of positions covering the vertices on the path
+// between u and v, both included.
+func (h *HeavyLight) Path(u, v int) []Segment {
+	t := h.tree
+	segments := []Segment{}
+	for h.head[u] != h.head[v] {
+		if t.depth[h.head[u]] < t.depth[h.head[v]] {
+			u, v = v, u
+		}
+		segments = append(segments, Segment{h.pos[h.head[u]], h.pos[u]})
+		u = t.parent[h.head[u]]
+	}
+	if h.pos[u] > h.pos[v] {
+		u, v = v, u
+	}
+	return append(segments, Segment{h.pos[u], h.pos[v]})
+}
+
+// Subtree returns the segment of positions covering the subtree of v.
+func (h *HeavyLight) Subtree(v int) Segment {
+	return Segment{h.pos[v], h.pos[v] + h.size[v] - 1}
+}
+
+// CentroidDecomposition returns the centroid tree: its root is a centroid of
+// the whole tree, and the children of every centroid are the centroids of the
+// components left after removing it. The centroid tree has depth O(log n) and
+// its edges all have weight 1.
+func (t *Tree) CentroidDecomposition() *Tree {
+	n := t.Size()
+	if n == 0 {
+		return newTree(0, -1)
+	}
+	removed := make([]bool, n)
+	size := make([]int, n)
+	parents := make([]int, n)
+	root := -1
+
+	type component struct {
+		start  int // any vertex in the component
+		parent int // centroid the component hangs from, -1 at the top
+	}
+	s := linkedliststack.New(component{t.root, -1})
+	for comp, ok := s.Pop(); ok; comp, ok = s.Pop() {
+		c := t.centroid(comp.start, removed, size)
+		removed[c] = true
+		parents[c] = comp.parent
+		if comp.parent == -1 {
+			root = c
+		}
+		for _, v := range t.neighbors(c) {
+			if !removed[v] {
+				s.Push(component{v, c})
+			}
+		}
+	}
+
+	ct := newTree(n, root)
+	ct.order = append(ct.order, root)
+	order, _ := t.componentOrder(root, make([]bool, n))
+	for _, v := range order {
+		if v != root {
+			ct.attach(parents[v], v, 1)
+		}
+	}
+	ct.order = ct.bfs()
+	return ct
+}
+
+// componentOrder lists the vertices reachable from start without crossing a
+// removed vertex, parents before children, and the vertex each was reached
+// from.
+func (t *Tree) componentOrder(start int, removed []bool) ([]int, map[int]int) {
+	order := []int{start}
+	from := map[int]int{start: -1}
+	for i := 0; i < len(order); i++ {
+		u := order[i]
+		for _, v := range t.neighbors(u) {
+			if v != from[u] && !removed[v] {
+				from[v] = u
+				order = append(order, v)
+			}
+		}
+	}
+	return order, from
+}
+
+// centroid finds a vertex of the component containing start whose removal
+// leaves no piece larger than half the component.
+func (t *Tree) centroid(start int, removed []bool, size []int) int {
+	order, from := t.componentOrder(start, removed)
+	for i := len(order) - 1; i >= 0; i-- {
+		u := order[i]
+		size[u] = 1
+		for _, v := range t.neighbors(u) {
+			if !removed[v] && v != from[u] {
+				size[u] += size[v]
+			}
+		}
+	}
+
+	total := len(order)
+	for _, u := range order {
+		largest := total - size[u]
+		for _, v := range t.neighbors(u) {
+			if !removed[v] && v != from[u] && size[v] > largest {
+				largest = size[v]
+			}
+		}
+		if 2*largest <= total {
+			return u
+		}
+	}
+	return start
+}
+
+// Diameter returns the length of the longest path in the tree, by total edge
+// weight, and the vertices on it. Edge weights must be non-negative.
+func (t *Tree) Diameter() (float64, []int) {
+	if t.Size() == 0 {
+		return 0, []int{}
+	}
+	a, _, _ := t.farthest(t.root)
+	b, length, from := t.farthest(a)
+	path := []int{}
+	for v := b; v != -1; v = from[v] {
+		path = append(path, v)
+	}
+	return length, path
+}
+
+// farthest returns the vertex furthest from start, its distance, and the
+// vertex each vertex was reached from.
+func (t *Tree) farthest(start int) (int, float64, map[int]int) {
+	order, from := t.componentOrder(start, make([]bool, t.Size()))
+	dist := map[int]float64{start: 0}
+	best := start
+	for _, v := range order[1:] {
+		dist[v] = dist[from[v]] + t.edgeWeight(from[v], v)
+		if dist[v] > dist[best] {
+			best = v
+		}
+	}
+	return best, dist[best], from
+}
@@ -0,0 +1,130 @@
+package rootedtree
+
+import "math/bits"
+
+// BinaryLifting answers lowest common ancestor and k-th ancestor queries in
+// O(log n) after O(n log n) preprocessing, by storing the 2^j-th ancestor of
+// every vertex.
+type BinaryLifting struct {
+	tree *Tree
+	up   [][]int // up[j][v] is the 2^j-th ancestor of v, or -1
+}
+
+func NewBinaryLifting(t *Tree) *BinaryLifting {
+	n := t.Size()
+	levels := bits.Len(uint(n))
+	if levels == 0 {
+		levels = 1
+	}
+	up := make([][]int, levels)
+	up[0] = make([]int, n)
+	copy(up[0], t.parent)
+	for j := 1; j < levels; j++ {
+		up[j] = make([]int, n)
+		for v := 0; v < n; v++ {
+			if mid := up[j-1][v]; mid == -1 {
+				up[j][v] = -1
+			} else {
+				up[j][v] = up[j-1][mid]
+			}
+		}
+	}
+	return &BinaryLifting{t, up}
+}
+
+// KthAncestor returns the ancestor k edges above v. Its parent is the first
+// ancestor and v is its own 0th ancestor.
+func (b *BinaryLifting) KthAncestor(v, k int) (int, bool) {
+	if k < 0 || k > b.tree.depth[v] {
+		return -1, false
+	}
+	for j := 0; k > 0; j, k = j+1, k>>1 {
+		if k&1 == 1 {
+			v = b.up[j][v]
+		}
+	}
+	return v, true
+}
+
+func (b *BinaryLifting) LCA(u, v int) int {
+	if b.tree.depth[u] < b.tree.depth[v] {
+		u, v = v, u
+	}
+	u, _ = b.KthAncestor(u, b.tree.depth[u]-b.tree.depth[v])
+	if u == v {
+		return u
+	}
+	for j := len(b.up) - 1; j >= 0; j-- {
+		if b.up[j][u] != b.up[j][v] {
+			u, v = b.up[j][u], b.up[j][v]
+		}
+	}
+	return b.tree.parent[u]
+}
+
+// EulerTour answers lowest common ancestor queries in O(1) after O(n log n)
+// preprocessing. The LCA of u and v is the shallowest vertex visited between
+// the first visits of u and v in an Euler tour, found with a sparse table.
+type EulerTour struct {
+	tree  *Tree
+	tour  []int   // vertices in the order they are visited, 2n-1 entries
+	first []int   // index of the first visit of each vertex in tour
+	table [][]int // table[j][i] is the shallowest vertex in tour[i : i+2^j]
+}
+
+func NewEulerTour(t *Tree) *EulerTour {
+	n := t.Size()
+	e := &EulerTour{tree: t, first: make([]int, n)}
+	if n == 0 {
+		return e
+	}
+
+	// iterative DFS: next[v] is the index of the next child of v to visit
+	next := make([]int, n)
+	stack := []int{t.root}
+	e.first[t.root] = 0
+	e.tour = append(e.tour, t.root)
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		if next[v] < len(t.children[v]) {
+			c := t.children[v][next[v]]
+			next[v]++
+			e.first[c] = len(e.tour)
+			e.tour = append(e.tour, c)
+			stack = append(stack, c)
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		if len(stack) > 0 {
+			e.tour = append(e.tour, stack[len(stack)-1])
+		}
+	}
+
+	m := len(e.tour)
+	e.table = [][]int{append([]int{}, e.tour...)}
+	for j := 1; 1<<j <= m; j++ {
+		prev := e.table[j-1]
+		row := make([]int, m-(1<<j)+1)
+		for i := range row {
+			row[i] = e.shallower(prev[i], prev[i+1<<(j-1)])
+		}
+		e.table = append(e.table, row)
+	}
+	return e
+}
+
+func (e *EulerTour) shallower(u, v int) int {
+	if e.tree.depth[u] <= e.tree.depth[v] {
+		return u
+	}
+	return v
+}
+
+func (e *EulerTour) LCA(u, v int) int {
+	l, r := e.first[u], e.first[v]
+	if l > r {
+		l, r = r, l
+	}
+	j := bits.Len(uint(r-l+1)) - 1
+	return e.shallower(e.table[j][l], e.table[j][r-(1<<j)+1])
+}
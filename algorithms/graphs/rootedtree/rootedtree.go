@@ -0,0 +1,249 @@
+package rootedtree
+
+import (
+	"fmt"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/queues/linkedlistqueue"
+	"github.com/mhrdini/godsa/datastructures/stacks/linkedliststack"
+	"github.com/mhrdini/godsa/datastructures/trees"
+)
+
+// Tree is a rooted tree over the vertices 0..n-1. It is built once from either
+// an undirected graph or a trees.INode hierarchy, and the query structures in
+// this package are built on top of it.
+type Tree struct {
+	root     int
+	parent   []int     // parent of each vertex, -1 for the root
+	weight   []float64 // weight of the edge to the parent, 0 for the root
+	depth    []int     // number of edges from the root
+	dist     []float64 // total edge weight from the root
+	children [][]int
+	order    []int // vertices in BFS order, so parents come before children
+}
+
+// FromGraph roots the undirected tree g at root. Edge weights become distances.
+// It fails if g is directed, disconnected or has a cycle.
+func FromGraph[W graphs.Number](g graphs.WeightedGraph[W], root int) (*Tree, error) {
+	n := g.Size()
+	if !g.Undirected() {
+		return nil, fmt.Errorf("error: graph is directed")
+	}
+	if root < 0 || root >= n {
+		return nil, fmt.Errorf("error: root %v is out of range", root)
+	}
+
+	t := newTree(n, root)
+	visited := make([]bool, n)
+	visited[root] = true
+	q := linkedlistqueue.New(root)
+	for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+		t.order = append(t.order, u)
+		for _, v := range g.Neighbors(u) {
+			if v == t.parent[u] {
+				continue
+			}
+			if visited[v] {
+				return nil, fmt.Errorf("error: graph has a cycle through %v and %v", u, v)
+			}
+			visited[v] = true
+			w, _ := g.Weight(u, v)
+			t.attach(u, v, float64(w))
+			q.Enqueue(v)
+		}
+	}
+	if len(t.order) != n {
+		return nil, fmt.Errorf("error: graph is disconnected, only %v of %v vertices are reachable from %v", len(t.order), n, root)
+	}
+	return t, nil
+}
+
+// FromNode numbers the non-nil nodes below root in preorder and returns the
+// tree together with the value of each vertex. Every edge has weight 1.
+func FromNode[T any](root trees.INode[T]) (*Tree, []T) {
+	values := []T{}
+	if root == nil || root.IsNil() {
+		return newTree(0, -1), values
+	}
+
+	type frame struct {
+		node   trees.INode[T]
+		parent int
+	}
+	parents := []int{}
+	s := linkedliststack.New(frame{root, -1})
+	for f, ok := s.Pop(); ok; f, ok = s.Pop() {
+		value, _ := f.node.Value()
+		values = append(values, value)
+		parents = append(parents, f.parent)
+		id := len(values) - 1
+		children := f.node.Children()
+		for i := len(children) - 1; i >= 0; i-- {
+			if children[i] != nil && !children[i].IsNil() {
+				s.Push(frame{children[i], id})
+			}
+		}
+	}
+
+	t := newTree(len(values), 0)
+	t.order = append(t.order, 0)
+	for v := 1; v < len(values); v++ {
+		t.attach(parents[v], v, 1)
+	}
+	// preorder numbering puts parents before children but not in BFS order
+	t.order = t.bfs()
+	return t, values
+}
+
+func newTree(n, root int) *Tree {
+	t := &Tree{
+		root:     root,
+		parent:   make([]int, n),
+		weight:   make([]float64, n),
+		depth:    make([]int, n),
+		dist:     make([]float64, n),
+		children: make([][]int, n),
+		order:    make([]int, 0, n),
+	}
+	for v := range t.parent {
+		t.parent[v] = -1
+	}
+	return t
+}
+
+func (t *Tree) attach(parent, child int, weight float64) {
+	t.parent[child] = parent
+	t.weight[child] = weight
+	t.depth[child] = t.depth[parent] + 1
+	t.dist[child] = t.dist[parent] + weight
+	t.children[parent] = append(t.children[parent], child)
+}
+
+func (t *Tree) bfs() []int {
+	order := []int{}
+	if t.Size() == 0 {
+		return order
+	}
+	q := linkedlistqueue.New(t.root)
+	for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+		order = append(order, u)
+		for _, v := range t.children[u] {
+			q.Enqueue(v)
+		}
+	}
+	return order
+}
+
+func (t *Tree) Size() int {
+	return len(t.parent)
+}
+
+func (t *Tree) Root() int {
+	return t.root
+}
+
+// Parent returns the parent of v, or -1 if v is the root.
+func (t *Tree) Parent(v int) int {
+	return t.parent[v]
+}
+
+func (t *Tree) Children(v int) []int {
+	return t.children[v]
+}
+
+// Depth returns the number of edges between the root and v.
+func (t *Tree) Depth(v int) int {
+	return t.depth[v]
+}
+
+// Dist returns the total edge weight between the root and v.
+func (t *Tree) Dist(v int) float64 {
+	return t.dist[v]
+}
+
+// neighbors returns the children of v and its parent, if any.
+func (t *Tree) neighbors(v int) []int {
+	if t.parent[v] == -1 {
+		return t.children[v]
+	}
+	return append([]int{t.parent[v]}, t.children[v]...)
+}
+
+// edgeWeight returns the weight of the tree edge between adjacent u and v.
+func (t *Tree) edgeWeight(u, v int) float64 {
+	if t.parent[v] == u {
+		return t.weight[v]
+	}
+	return t.weight[u]
+}
+
+// DP computes a value for every vertex from the values of its children, bottom
+// up. f receives the children's values in the same order as Children(v).
+func DP[S any](t *Tree, f func(v int, children []S) S) []S {
+	result := make([]S, t.Size())
+	for i := len(t.order) - 1; i >= 0; i-- {
+		v := t.order[i]
+		children := make([]S, len(t.children[v]))
+		for j, c := range t.children[v] {
+			children[j] = result[c]
+		}
+		result[v] = f(v, children)
+	}
+	return result
+}
+
+// SubtreeSizes returns the number of vertices in the subtree of every vertex.
+func (t *Tree) SubtreeSizes() []int {
+	return DP(t, func(v int, children []int) int {
+		size := 1
+		for _, s := range children {
+			size += s
+		}
+		return size
+	})
+}
+
+// LCA is implemented by every lowest common ancestor structure in this
+// package.
+type LCA interface {
+	LCA(u, v int) int
+}
+
+// Distance returns the total edge weight on the path between u and v, using l
+// to find their lowest common ancestor.
+func (t *Tree) Distance(l LCA, u, v int) float64 {
+	return t.dist[u] + t.dist[v] - 2*t.dist[l.LCA(u, v)]
+}
+
+// Hops returns the number of edges on the path between u and v, using l to
+// find their lowest common ancestor.
+func (t *Tree) Hops(l LCA, u, v int) int {
+	return t.depth[u] + t.depth[v] - 2*t.depth[l.LCA(u, v)]
+}
+
+func Demo() {
+	g := adjacencylist.New(graphs.Options{
+		TotalVertices: 8,
+		Undirected:    true,
+	})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(1, 4, 1)
+	g.AddEdge(2, 5, 1)
+	g.AddEdge(3, 6, 1)
+	g.AddEdge(5, 7, 1)
+
+	t, err := FromGraph(g, 0)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	bl := NewBinaryLifting(t)
+	fmt.Println("lca(6, 4):", bl.LCA(6, 4))
+	fmt.Println("lca(6, 7):", NewEulerTour(t).LCA(6, 7))
+	fmt.Println("distance(6, 7):", t.Distance(bl, 6, 7))
+	length, path := t.Diameter()
+	fmt.Println("diameter:", length, path)
+}
@@ -0,0 +1,229 @@
+package rootedtree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/datastructures/trees/searchtrees/bst"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// example is rooted at 0 with children listed left to right:
+// 0 -> 1, 2; 1 -> 3, 4; 2 -> 5; 3 -> 6; 5 -> 7
+var example = []graphtest.Edge{{0, 1, 1}, {0, 2, 2}, {1, 3, 3}, {1, 4, 1}, {2, 5, 1}, {3, 6, 1}, {5, 7, 4}}
+
+func build(t *testing.T, n int, edges []graphtest.Edge, root int) *Tree {
+	t.Helper()
+	tree, err := FromGraph(graphtest.Build(n, true, edges), root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tree
+}
+
+func randomTree(rng *rand.Rand, n int) []graphtest.Edge {
+	edges := []graphtest.Edge{}
+	for v := 1; v < n; v++ {
+		edges = append(edges, graphtest.Edge{rng.Intn(v), v, 1 + rng.Intn(9)})
+	}
+	return edges
+}
+
+// naiveLCA walks both vertices up to the same depth and then up together.
+func naiveLCA(t *Tree, u, v int) int {
+	for t.depth[u] > t.depth[v] {
+		u = t.parent[u]
+	}
+	for t.depth[v] > t.depth[u] {
+		v = t.parent[v]
+	}
+	for u != v {
+		u, v = t.parent[u], t.parent[v]
+	}
+	return u
+}
+
+func TestFromGraph(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		n          int
+		undirected bool
+		edges      []graphtest.Edge
+		ok         bool
+	}{
+		{"tree", 8, true, example, true},
+		{"cycle", 3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}}, false},
+		{"forest", 4, true, []graphtest.Edge{{0, 1, 1}, {2, 3, 1}}, false},
+		{"directed", 2, false, []graphtest.Edge{{0, 1, 1}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := FromGraph(graphtest.Build(tc.n, tc.undirected, tc.edges), 0)
+			helpers.AssertEqual(t, err == nil, tc.ok)
+		})
+	}
+}
+
+func TestFromNode(t *testing.T) {
+	tree, values := FromNode(bst.New(comparator.OrderedComparator[int], 4, 2, 6, 1, 3, 5, 7).Root())
+	helpers.AssertEqual(t, helpers.ToString(values), "[4 2 1 3 6 5 7]")
+
+	id := map[int]int{}
+	for v, value := range values {
+		id[value] = v
+	}
+	bl := NewBinaryLifting(tree)
+	helpers.AssertEqual(t, values[bl.LCA(id[1], id[3])], 2)
+	helpers.AssertEqual(t, values[bl.LCA(id[1], id[7])], 4)
+	helpers.AssertEqual(t, tree.Hops(bl, id[1], id[7]), 4)
+}
+
+func TestLCA(t *testing.T) {
+	tree := build(t, 8, example, 0)
+	structures := []LCA{NewBinaryLifting(tree), NewEulerTour(tree), NewHeavyLight(tree)}
+
+	testCases := []struct {
+		u, v int
+		lca  int
+		dist float64
+		hops int
+	}{
+		{6, 4, 1, 5, 3},
+		{6, 7, 0, 12, 6},
+		{3, 6, 3, 1, 1},
+		{5, 5, 5, 0, 0},
+		{0, 7, 0, 7, 3},
+	}
+
+	for _, l := range structures {
+		for _, tc := range testCases {
+			t.Run(fmt.Sprintf("%T (%v, %v)", l, tc.u, tc.v), func(t *testing.T) {
+				helpers.AssertEqual(t, l.LCA(tc.u, tc.v), tc.lca)
+				helpers.AssertEqual(t, l.LCA(tc.v, tc.u), tc.lca)
+				helpers.AssertEqual(t, tree.Distance(l, tc.u, tc.v), tc.dist)
+				helpers.AssertEqual(t, tree.Hops(l, tc.u, tc.v), tc.hops)
+			})
+		}
+	}
+
+	t.Run("random trees", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 20; trial++ {
+			n := 1 + rng.Intn(200)
+			tree := build(t, n, randomTree(rng, n), rng.Intn(n))
+			structures := []LCA{NewBinaryLifting(tree), NewEulerTour(tree), NewHeavyLight(tree)}
+			for q := 0; q < 100; q++ {
+				u, v := rng.Intn(n), rng.Intn(n)
+				want := naiveLCA(tree, u, v)
+				for _, l := range structures {
+					helpers.AssertEqual(t, l.LCA(u, v), want)
+				}
+			}
+		}
+	})
+}
+
+func TestKthAncestor(t *testing.T) {
+	bl := NewBinaryLifting(build(t, 8, example, 0))
+	testCases := []struct {
+		v, k int
+		want int
+		ok   bool
+	}{
+		{6, 0, 6, true},
+		{6, 1, 3, true},
+		{6, 2, 1, true},
+		{6, 3, 0, true},
+		{6, 4, -1, false},
+		{0, 1, -1, false},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v of %v", tc.k, tc.v), func(t *testing.T) {
+			got, ok := bl.KthAncestor(tc.v, tc.k)
+			helpers.AssertEqual(t, got, tc.want)
+			helpers.AssertEqual(t, ok, tc.ok)
+		})
+	}
+}
+
+func TestDiameter(t *testing.T) {
+	t.Run("weighted", func(t *testing.T) {
+		length, path := build(t, 8, example, 0).Diameter()
+		helpers.AssertEqual(t, length, 12.0)
+		helpers.AssertEqual(t, len(path), 7)
+	})
+
+	t.Run("unweighted", func(t *testing.T) {
+		length, path := build(t, 5, []graphtest.Edge{{0, 1, 1}, {0, 2, 1}, {2, 3, 1}, {0, 4, 1}}, 4).Diameter()
+		helpers.AssertEqual(t, length, 3.0)
+		helpers.AssertEqual(t, len(path), 4)
+	})
+}
+
+func TestHeavyLight(t *testing.T) {
+	tree := build(t, 8, example, 0)
+	h := NewHeavyLight(tree)
+
+	t.Run("subtrees are contiguous", func(t *testing.T) {
+		for v := 0; v < tree.Size(); v++ {
+			s := h.Subtree(v)
+			for u := 0; u < tree.Size(); u++ {
+				inside := s.From <= h.Pos(u) && h.Pos(u) <= s.To
+				helpers.AssertEqual(t, inside, naiveLCA(tree, u, v) == v)
+			}
+		}
+	})
+
+	t.Run("paths are covered exactly", func(t *testing.T) {
+		for u := 0; u < tree.Size(); u++ {
+			for v := 0; v < tree.Size(); v++ {
+				covered := 0
+				for _, s := range h.Path(u, v) {
+					helpers.Assert(t, s.From <= s.To)
+					covered += s.To - s.From + 1
+				}
+				helpers.AssertEqual(t, covered, tree.Hops(h, u, v)+1)
+			}
+		}
+	})
+}
+
+func TestCentroidDecomposition(t *testing.T) {
+	// path 0 - 1 - 2 - 3 - 4 - 5 - 6
+	path := []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 4, 1}, {4, 5, 1}, {5, 6, 1}}
+	ct := build(t, 7, path, 0).CentroidDecomposition()
+	helpers.AssertEqual(t, ct.Root(), 3)
+	helpers.AssertEqual(t, ct.Parent(1), 3)
+	helpers.AssertEqual(t, ct.Parent(5), 3)
+	helpers.AssertEqual(t, ct.Parent(0), 1)
+	helpers.AssertEqual(t, ct.Parent(6), 5)
+
+	t.Run("depth is logarithmic", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(2))
+		n := 500
+		ct := build(t, n, randomTree(rng, n), 0).CentroidDecomposition()
+		sizes := ct.SubtreeSizes()
+		helpers.AssertEqual(t, sizes[ct.Root()], n)
+		for v := 0; v < n; v++ {
+			helpers.Assert(t, ct.Depth(v) <= 9)
+		}
+	})
+}
+
+func TestDP(t *testing.T) {
+	tree := build(t, 8, example, 0)
+	helpers.AssertEqual(t, helpers.ToString(tree.SubtreeSizes()), "[8 4 3 2 1 2 1 1]")
+
+	heights := DP(tree, func(v int, children []int) int {
+		h := 0
+		for _, c := range children {
+			h = max(h, c+1)
+		}
+		return h
+	})
+	helpers.AssertEqual(t, helpers.ToString(heights), "[3 2 2 1 0 1 0 0]")
+}
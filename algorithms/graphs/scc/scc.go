@@ -13,9 +13,21 @@ import (
 )
 
 // Strongly Connected Components using Kosaraju's Algorithm
+//
+// Components are returned in topological order of the condensation: no edge
+// leads from a component to one listed before it.
 
-func Run(g datastructures.Graph) [][]int {
-	vertices := dfs.Run(g, g.Values()[0])
+func Run(g datastructures.Topology) [][]int {
+	vertices := make([]*graphs.Vertex, g.Size())
+	for i := 0; i < g.Size(); i++ {
+		vertices[i] = &graphs.Vertex{Color: graphs.White, Value: i, Parent: nil}
+	}
+	time := 0
+	for i := 0; i < g.Size(); i++ {
+		if vertices[i].Color == graphs.White {
+			dfs.Visit(g, vertices, i, &time)
+		}
+	}
 	sorter.Sort(vertices, func(a, b *graphs.Vertex) int {
 		if a.Dist < b.Dist {
 			return comparator.Greater
@@ -26,7 +38,13 @@ func Run(g datastructures.Graph) [][]int {
 		}
 	})
 
-	transpose := g.Transpose()
+	// the second pass follows edges backwards
+	transpose := make([][]int, g.Size())
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			transpose[v] = append(transpose[v], u)
+		}
+	}
 	visited := map[int]bool{}
 	components := make([][]int, 0)
 	s := linkedliststack.New[int]()
@@ -39,7 +57,7 @@ func Run(g datastructures.Graph) [][]int {
 					c = append(c, v)
 					visited[v] = true
 				}
-				for _, u := range transpose.Neighbors(v) {
+				for _, u := range transpose[v] {
 					if done, ok := visited[u]; !ok || !done {
 						s.Push(u)
 					}
@@ -51,6 +69,33 @@ func Run(g datastructures.Graph) [][]int {
 	return components
 }
 
+// Condensation contracts every strongly connected component of g into a
+// single vertex. It returns the resulting DAG, whose vertex i is the i-th
+// component returned by Run, and the component of every vertex of g. Edges
+// between components have weight 1.
+func Condensation(g datastructures.Topology) (datastructures.Graph, []int) {
+	components := Run(g)
+	component := make([]int, g.Size())
+	for c, vs := range components {
+		for _, v := range vs {
+			component[v] = c
+		}
+	}
+
+	dag := adjacencylist.New(datastructures.Options{
+		TotalVertices: uint32(len(components)),
+		Undirected:    false,
+	})
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			if cu, cv := component[u], component[v]; cu != cv && !dag.Adjacent(cu, cv) {
+				dag.AddEdge(cu, cv, 1)
+			}
+		}
+	}
+	return dag, component
+}
+
 func Demo() {
 	g := adjacencylist.New(datastructures.Options{
 		TotalVertices: 8,
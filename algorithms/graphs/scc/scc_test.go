@@ -0,0 +1,31 @@
+package scc
+
+import (
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestRun(t *testing.T) {
+	// 0 cannot reach the cycle {1, 2} or 3, which leads into the cycle
+	g := adjacencylist.New(datastructures.Options{TotalVertices: 4})
+	g.AddEdge(1, 0, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 1, 1)
+	g.AddEdge(3, 2, 1)
+
+	components := Run(g)
+	helpers.AssertEqual(t, len(components), 3)
+
+	dag, component := Condensation(g)
+	helpers.AssertEqual(t, dag.Size(), 3)
+	helpers.AssertEqual(t, component[1], component[2])
+	helpers.Assert(t, component[0] != component[1])
+	helpers.Assert(t, component[3] != component[1])
+
+	// components are listed in topological order
+	helpers.Assert(t, component[3] < component[1])
+	helpers.Assert(t, component[1] < component[0])
+}
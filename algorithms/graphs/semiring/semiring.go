@@ -0,0 +1,203 @@
+package semiring
+
+import (
+	"fmt"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+)
+
+// A path problem is described by a semiring over the edge weights: Times
+// extends a path by an edge and Plus picks between two paths. The value of a
+// pair of vertices is then the Plus over every path between them of the Times
+// along its edges. Shortest paths use (min, +), widest paths (max, min) and
+// most reliable paths (max, *).
+//
+// The algorithms here stop once no value improves, which is only guaranteed to
+// give the answer when Plus is idempotent and going around a cycle never
+// improves a path, e.g. no negative cycles for shortest paths.
+
+type Semiring[W comparable] interface {
+	// Zero is the identity of Plus: the value of no path at all.
+	Zero() W
+	// One is the identity of Times: the value of the empty path.
+	One() W
+	Plus(a, b W) W
+	Times(a, b W) W
+}
+
+// largest returns the largest value of W, which is +Inf for floating point
+// types.
+func largest[W datastructures.Number]() W {
+	m := W(1)
+	for m*2 > m {
+		m *= 2
+	}
+	return m + (m - 1)
+}
+
+// smallest returns the smallest value of W, which is -Inf for floating point
+// types.
+func smallest[W datastructures.Number]() W {
+	var zero W
+	if zero-1 > zero {
+		return zero
+	}
+	return -largest[W]() - 1
+}
+
+type minPlus[W datastructures.Number] struct{ inf W }
+
+// MinPlus returns the semiring of shortest paths. Unreachable vertices get
+// the largest value of W, which is +Inf for floating point types.
+func MinPlus[W datastructures.Number]() Semiring[W] {
+	return minPlus[W]{largest[W]()}
+}
+
+func (s minPlus[W]) Zero() W { return s.inf }
+func (s minPlus[W]) One() W  { return 0 }
+
+func (s minPlus[W]) Plus(a, b W) W {
+	return min(a, b)
+}
+
+func (s minPlus[W]) Times(a, b W) W {
+	if a == s.inf || b == s.inf {
+		return s.inf
+	}
+	return a + b
+}
+
+type maxMin[W datastructures.Number] struct{ inf, negInf W }
+
+// MaxMin returns the semiring of widest paths, whose value is the smallest
+// weight along them, such as the capacity of a route.
+func MaxMin[W datastructures.Number]() Semiring[W] {
+	return maxMin[W]{largest[W](), smallest[W]()}
+}
+
+func (s maxMin[W]) Zero() W { return s.negInf }
+func (s maxMin[W]) One() W  { return s.inf }
+
+func (s maxMin[W]) Plus(a, b W) W {
+	return max(a, b)
+}
+
+func (s maxMin[W]) Times(a, b W) W {
+	return min(a, b)
+}
+
+type maxTimes[W datastructures.Number] struct{}
+
+// MaxTimes returns the semiring of most reliable paths, whose value is the
+// product of the weights along them, such as the probability that every edge
+// on a route works. Weights should be between 0 and 1.
+func MaxTimes[W datastructures.Number]() Semiring[W] {
+	return maxTimes[W]{}
+}
+
+func (s maxTimes[W]) Zero() W { return 0 }
+func (s maxTimes[W]) One() W  { return 1 }
+
+func (s maxTimes[W]) Plus(a, b W) W {
+	return max(a, b)
+}
+
+func (s maxTimes[W]) Times(a, b W) W {
+	return a * b
+}
+
+// SingleSource returns the value of every vertex as seen from src, using the
+// Bellman-Ford iteration with the operations of s in O(VE).
+func SingleSource[W datastructures.Number](g datastructures.WeightedGraph[W], src int, s Semiring[W]) []W {
+	return SingleSourceFunc(g, src, s, weight[W])
+}
+
+// SingleSourceFunc is SingleSource over a semiring whose values need not be
+// numbers, such as a distance paired with a number of hops. The value of the
+// edge from u to v of weight w is value(u, v, w).
+func SingleSourceFunc[W datastructures.Number, V comparable](g datastructures.WeightedGraph[W], src int, s Semiring[V], value func(u, v int, w W) V) []V {
+	n := g.Size()
+	values := make([]V, n)
+	for v := range values {
+		values[v] = s.Zero()
+	}
+	values[src] = s.One()
+
+	for round := 0; round < n; round++ {
+		changed := false
+		for u := 0; u < n; u++ {
+			if values[u] == s.Zero() {
+				continue
+			}
+			for _, v := range g.Neighbors(u) {
+				w, _ := g.Weight(u, v)
+				if next := s.Plus(values[v], s.Times(values[u], value(u, v, w))); next != values[v] {
+					values[v] = next
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return values
+}
+
+// AllPairs returns the value of every pair of vertices, using the
+// Floyd-Warshall iteration with the operations of s in O(V^3).
+func AllPairs[W datastructures.Number](g datastructures.WeightedGraph[W], s Semiring[W]) [][]W {
+	return AllPairsFunc(g, s, weight[W])
+}
+
+// AllPairsFunc is AllPairs over a semiring whose values need not be numbers,
+// with the value of each edge given by value as in SingleSourceFunc.
+func AllPairsFunc[W datastructures.Number, V comparable](g datastructures.WeightedGraph[W], s Semiring[V], value func(u, v int, w W) V) [][]V {
+	n := g.Size()
+	values := make([][]V, n)
+	for u := range values {
+		values[u] = make([]V, n)
+		for v := range values[u] {
+			values[u][v] = s.Zero()
+		}
+		values[u][u] = s.One()
+		for _, v := range g.Neighbors(u) {
+			w, _ := g.Weight(u, v)
+			values[u][v] = s.Plus(values[u][v], value(u, v, w))
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if values[i][k] == s.Zero() {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				values[i][j] = s.Plus(values[i][j], s.Times(values[i][k], values[k][j]))
+			}
+		}
+	}
+	return values
+}
+
+// weight is the value of an edge when the semiring is over the weights
+// themselves.
+func weight[W datastructures.Number](u, v int, w W) W {
+	return w
+}
+
+func Demo() {
+	// probabilities that each link of a network stays up
+	g := adjacencylist.NewWeighted[float64](datastructures.Options{
+		TotalVertices: 4,
+		Undirected:    true,
+	})
+	g.AddEdge(0, 1, 0.9)
+	g.AddEdge(1, 3, 0.9)
+	g.AddEdge(0, 2, 0.99)
+	g.AddEdge(2, 3, 0.7)
+
+	fmt.Println(SingleSource(g, 0, MaxTimes[float64]()))
+	fmt.Println(SingleSource(g, 0, MinPlus[float64]()))
+}
@@ -0,0 +1,131 @@
+package semiring
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestBounds(t *testing.T) {
+	helpers.AssertEqual(t, largest[int8](), int8(math.MaxInt8))
+	helpers.AssertEqual(t, smallest[int8](), int8(math.MinInt8))
+	helpers.AssertEqual(t, largest[uint8](), uint8(math.MaxUint8))
+	helpers.AssertEqual(t, smallest[uint8](), uint8(0))
+	helpers.AssertEqual(t, largest[int](), math.MaxInt)
+	helpers.AssertEqual(t, smallest[int](), math.MinInt)
+	helpers.AssertEqual(t, largest[uint64](), uint64(math.MaxUint64))
+	helpers.AssertEqual(t, largest[float64](), math.Inf(1))
+	helpers.AssertEqual(t, smallest[float32](), float32(math.Inf(-1)))
+}
+
+func TestMinPlus(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 20; trial++ {
+		n := 1 + rng.Intn(30)
+		o := datastructures.Options{TotalVertices: uint32(n), Undirected: trial%2 == 0}
+		ints := adjacencylist.New(o)
+		floats := adjacencymatrix.NewWeighted[float64](o)
+		for i := 0; i < 3*n; i++ {
+			u, v, w := rng.Intn(n), rng.Intn(n), 1+rng.Intn(20)
+			ints.AddEdge(u, v, w)
+			floats.AddEdge(u, v, float64(w)/4)
+		}
+
+		src := rng.Intn(n)
+		want := dijkstra.Run(ints, src)
+		gotInts := SingleSource(ints, src, MinPlus[int]())
+		gotFloats := SingleSource(floats, src, MinPlus[float64]())
+		all := AllPairs(floats, MinPlus[float64]())
+		for v := range want {
+			if math.IsInf(want[v].Dist, 1) {
+				helpers.AssertEqual(t, gotInts[v], math.MaxInt)
+			} else {
+				helpers.AssertEqual(t, float64(gotInts[v]), want[v].Dist)
+			}
+			helpers.AssertEqual(t, gotFloats[v], want[v].Dist/4)
+			helpers.AssertEqual(t, all[src][v], gotFloats[v])
+		}
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	// two routes from 0 to 3 with bottlenecks 4 and 6
+	g := adjacencylist.NewWeighted[uint16](datastructures.Options{TotalVertices: 5})
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(1, 3, 4)
+	g.AddEdge(0, 2, 6)
+	g.AddEdge(2, 3, 8)
+	g.AddEdge(3, 0, 1)
+
+	widest := SingleSource(g, 0, MaxMin[uint16]())
+	helpers.AssertEqual(t, helpers.ToString(widest), "[65535 10 6 6 0]")
+
+	all := AllPairs(g, MaxMin[uint16]())
+	for u := range all {
+		helpers.AssertEqual(t, helpers.ToString(all[u]), helpers.ToString(SingleSource(g, u, MaxMin[uint16]())))
+	}
+}
+
+func TestMaxTimes(t *testing.T) {
+	g := adjacencylist.NewWeighted[float64](datastructures.Options{TotalVertices: 4, Undirected: true})
+	g.AddEdge(0, 1, 0.9)
+	g.AddEdge(1, 3, 0.9)
+	g.AddEdge(0, 2, 0.99)
+	g.AddEdge(2, 3, 0.7)
+
+	reliability := SingleSource(g, 0, MaxTimes[float64]())
+	helpers.AssertInDelta(t, reliability[3], 0.81, 1e-12)
+	helpers.AssertInDelta(t, reliability[2], 0.99, 1e-12)
+	helpers.AssertInDelta(t, AllPairs(g, MaxTimes[float64]())[2][1], 0.891, 1e-12)
+}
+
+// distHops is the length of a path and its number of edges.
+type distHops struct {
+	dist float64
+	hops int
+}
+
+// fewestHops is the semiring of shortest paths that breaks ties by taking
+// the path with the fewest edges.
+type fewestHops struct{}
+
+func (fewestHops) Zero() distHops { return distHops{math.Inf(1), 0} }
+func (fewestHops) One() distHops  { return distHops{0, 0} }
+
+func (fewestHops) Plus(a, b distHops) distHops {
+	if b.dist < a.dist || b.dist == a.dist && b.hops < a.hops {
+		return b
+	}
+	return a
+}
+
+func (fewestHops) Times(a, b distHops) distHops {
+	return distHops{a.dist + b.dist, a.hops + b.hops}
+}
+
+func TestStructValues(t *testing.T) {
+	// 0 reaches 3 in 4 either through 1 and 2 or directly
+	g := adjacencylist.NewWeighted[float64](datastructures.Options{TotalVertices: 5})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 2)
+	g.AddEdge(0, 3, 4)
+	edge := func(u, v int, w float64) distHops { return distHops{w, 1} }
+
+	values := SingleSourceFunc(g, 0, fewestHops{}, edge)
+	helpers.AssertEqual(t, values[3], distHops{4, 1})
+	helpers.AssertEqual(t, values[2], distHops{2, 2})
+	helpers.AssertEqual(t, values[4], fewestHops{}.Zero())
+
+	all := AllPairsFunc(g, fewestHops{}, edge)
+	for u := range all {
+		helpers.AssertEqual(t, helpers.ToString(all[u]), helpers.ToString(SingleSourceFunc(g, u, fewestHops{}, edge)))
+	}
+	helpers.AssertEqual(t, all[1][3], distHops{3, 2})
+}
@@ -2,6 +2,7 @@ package toposort
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/mhrdini/godsa/algorithms/graphs"
 	"github.com/mhrdini/godsa/algorithms/graphs/dfs"
@@ -13,7 +14,7 @@ import (
 	"github.com/mhrdini/godsa/datastructures/utils/sorter"
 )
 
-func SortBFS(g datastructures.Graph) []int {
+func SortBFS(g datastructures.Topology) []int {
 	fmt.Println("Running Topological Sort using BFS...")
 
 	inDegrees := make([]int, g.Size())
@@ -62,7 +63,7 @@ func findZeroInDegree(inDegrees []int) []int {
 	return zeros
 }
 
-func updateInDegrees(g datastructures.Graph, inDegrees []int, removed int) {
+func updateInDegrees(g datastructures.Topology, inDegrees []int, removed int) {
 	inDegrees[removed] = -1
 	affected := g.Neighbors(removed)
 	for _, v := range affected {
@@ -72,7 +73,70 @@ func updateInDegrees(g datastructures.Graph, inDegrees []int, removed int) {
 	}
 }
 
-func SortDFS(g datastructures.Graph) []int {
+// Sort returns the vertices of g in topological order using Kahn's algorithm,
+// taking vertices whose predecessors are all placed in increasing order. If g
+// has a cycle the order is incomplete and cycle holds the vertices of one
+// cycle, each with an edge to the next and the last with an edge back to the
+// first; otherwise cycle is nil.
+func Sort(g datastructures.Topology) (order []int, cycle []int) {
+	inDegrees := make([]int, g.Size())
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			inDegrees[v]++
+		}
+	}
+
+	order = []int{}
+	q := linkedlistqueue.New[int]()
+	for v, d := range inDegrees {
+		if d == 0 {
+			q.Enqueue(v)
+		}
+	}
+	for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+		order = append(order, u)
+		for _, v := range g.Neighbors(u) {
+			if inDegrees[v]--; inDegrees[v] == 0 {
+				q.Enqueue(v)
+			}
+		}
+	}
+	if len(order) == g.Size() {
+		return order, nil
+	}
+	return order, findCycle(g, inDegrees)
+}
+
+// findCycle walks backwards from a vertex Sort could not place. Every such
+// vertex still has a predecessor that could not be placed, so the walk must
+// eventually repeat a vertex.
+func findCycle(g datastructures.Topology, inDegrees []int) []int {
+	pred := make([]int, g.Size())
+	start := -1
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			if inDegrees[u] > 0 && inDegrees[v] > 0 {
+				pred[v] = u
+				start = v
+			}
+		}
+	}
+
+	seen := make([]bool, g.Size())
+	v := start
+	for !seen[v] {
+		seen[v] = true
+		v = pred[v]
+	}
+	cycle := []int{v}
+	for u := pred[v]; u != v; u = pred[u] {
+		cycle = append(cycle, u)
+	}
+	slices.Reverse(cycle)
+	return cycle
+}
+
+func SortDFS(g datastructures.Topology) []int {
 	fmt.Println("Running Topological Sort using DFS...")
 
 	vertices := make([]*graphs.Vertex, g.Size())
@@ -0,0 +1,37 @@
+package toposort
+
+import (
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestSort(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		n     int
+		edges [][2]int
+		order string
+		cycle string
+	}{
+		{"empty", 0, nil, "[]", "[]"},
+		{"chain", 3, [][2]int{{2, 1}, {1, 0}}, "[2 1 0]", "[]"},
+		{"diamond", 4, [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}}, "[0 1 2 3]", "[]"},
+		{"self-loop", 2, [][2]int{{0, 1}, {1, 1}}, "[0]", "[1]"},
+		{"cycle", 5, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 1}, {3, 4}}, "[0]", "[1 2 3]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(tc.n)})
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], e[1], 1)
+			}
+			order, cycle := Sort(g)
+			helpers.AssertEqual(t, helpers.ToString(order), tc.order)
+			helpers.AssertEqual(t, helpers.ToString(cycle), tc.cycle)
+		})
+	}
+}
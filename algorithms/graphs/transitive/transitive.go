@@ -0,0 +1,211 @@
+package transitive
+
+import (
+	"fmt"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/bitmatrix"
+	"github.com/mhrdini/godsa/datastructures/queues/linkedlistqueue"
+)
+
+// The transitive closure of a directed graph has an edge (u, v) whenever v is
+// reachable from u. A transitive reduction is a graph with as few edges as
+// possible that has the same transitive closure.
+
+// Closure returns the transitive closure of g, using Warshall's algorithm on
+// an adjacency matrix or a bit matrix, in the same representation, and a BFS
+// from every vertex otherwise. A vertex only has a self-loop in the closure if
+// it lies on a cycle. Every edge has weight 1.
+func Closure[W datastructures.Number](g datastructures.WeightedGraph[W]) datastructures.Graph {
+	switch any(g).(type) {
+	case *bitmatrix.Graph:
+		return fromReach(bitmatrix.New, warshall(g))
+	case *adjacencymatrix.Graph[W]:
+		return Warshall(g)
+	}
+	return ClosureBFS(g)
+}
+
+// Warshall computes the transitive closure in O(V^3 / 64) time and returns it
+// as an adjacency matrix. It suits dense graphs.
+func Warshall(g datastructures.Topology) datastructures.Graph {
+	return fromReach(adjacencymatrix.New, warshall(g))
+}
+
+// warshall keeps every row of the reachability matrix in 64-bit words, so
+// that adding the vertices reachable from k to those reachable from i takes
+// V/64 word operations.
+func warshall(g datastructures.Topology) [][]bool {
+	n := g.Size()
+	rows := make([][]uint64, n)
+	for u := range rows {
+		rows[u] = make([]uint64, (n+63)/64)
+		for _, v := range g.Neighbors(u) {
+			rows[u][v/64] |= 1 << (v % 64)
+		}
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if rows[i][k/64]&(1<<(k%64)) == 0 {
+				continue
+			}
+			for j, w := range rows[k] {
+				rows[i][j] |= w
+			}
+		}
+	}
+
+	reach := make([][]bool, n)
+	for u := range reach {
+		reach[u] = make([]bool, n)
+		for v := range reach[u] {
+			reach[u][v] = rows[u][v/64]&(1<<(v%64)) != 0
+		}
+	}
+	return reach
+}
+
+// ClosureBFS computes the transitive closure in O(V(V+E)) time by searching
+// from every vertex and returns it as an adjacency list. It suits sparse
+// graphs.
+func ClosureBFS(g datastructures.Topology) datastructures.Graph {
+	return fromReach(adjacencylist.New, reachability(g))
+}
+
+// reachability returns reach[u][v] = true if there is a non-empty path from u
+// to v.
+func reachability(g datastructures.Topology) [][]bool {
+	n := g.Size()
+	reach := make([][]bool, n)
+	for src := 0; src < n; src++ {
+		reach[src] = make([]bool, n)
+		q := linkedlistqueue.New(src)
+		for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+			for _, v := range g.Neighbors(u) {
+				if !reach[src][v] {
+					reach[src][v] = true
+					q.Enqueue(v)
+				}
+			}
+		}
+	}
+	return reach
+}
+
+func fromReach(newGraph func(datastructures.Options) datastructures.Graph, reach [][]bool) datastructures.Graph {
+	closure := newGraph(datastructures.Options{
+		TotalVertices: uint32(len(reach)),
+		Undirected:    false,
+	})
+	for u := range reach {
+		for v, ok := range reach[u] {
+			if ok {
+				closure.AddEdge(u, v, 1)
+			}
+		}
+	}
+	return closure
+}
+
+// like returns an empty directed graph with n vertices and weights of type V,
+// using the same representation as g.
+func like[W, V datastructures.Number](g datastructures.WeightedGraph[W], n int) datastructures.WeightedGraph[V] {
+	o := datastructures.Options{
+		TotalVertices: uint32(n),
+		Undirected:    false,
+	}
+	if _, ok := g.(*adjacencymatrix.Graph[W]); ok {
+		return adjacencymatrix.NewWeighted[V](o)
+	}
+	return adjacencylist.NewWeighted[V](o)
+}
+
+// Reduction returns the transitive reduction of the DAG g: the unique subgraph
+// that keeps an edge (u, v) only if there is no other path from u to v. Edge
+// weights are kept. It fails if g has a cycle.
+func Reduction[W datastructures.Number](g datastructures.WeightedGraph[W]) (datastructures.WeightedGraph[W], error) {
+	for _, c := range scc.Run(g) {
+		if len(c) > 1 || g.Adjacent(c[0], c[0]) {
+			return nil, fmt.Errorf("error: graph has a cycle through %v", c)
+		}
+	}
+
+	reach := reachability(g)
+	reduction := like[W, W](g, g.Size())
+	for u := 0; u < g.Size(); u++ {
+		neighbors := g.Neighbors(u)
+		for _, v := range neighbors {
+			if !redundant(reach, neighbors, v) {
+				w, _ := g.Weight(u, v)
+				reduction.AddEdge(u, v, w)
+			}
+		}
+	}
+	return reduction, nil
+}
+
+// redundant reports whether v can be reached through some other neighbour.
+func redundant(reach [][]bool, neighbors []int, v int) bool {
+	for _, w := range neighbors {
+		if w != v && reach[w][v] {
+			return true
+		}
+	}
+	return false
+}
+
+// MinimumEquivalent returns a graph with as few edges as possible and the
+// same reachability as g, which may have cycles. Each strongly connected
+// component becomes a simple cycle through its vertices, and components are
+// joined according to the transitive reduction of the condensation. Unlike
+// Reduction the result need not be a subgraph of g, so every edge has weight
+// 1.
+func MinimumEquivalent[W datastructures.Number](g datastructures.WeightedGraph[W]) datastructures.Graph {
+	components := scc.Run(g)
+	dag, _ := scc.Condensation(g)
+	reduced, _ := Reduction(dag)
+
+	result := like[W, int](g, g.Size())
+	for _, c := range components {
+		if len(c) == 1 {
+			if g.Adjacent(c[0], c[0]) {
+				result.AddEdge(c[0], c[0], 1)
+			}
+			continue
+		}
+		for i := range c {
+			result.AddEdge(c[i], c[(i+1)%len(c)], 1)
+		}
+	}
+	for cu := range components {
+		for _, cv := range reduced.Neighbors(cu) {
+			result.AddEdge(components[cu][0], components[cv][0], 1)
+		}
+	}
+	return result
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 5,
+		Undirected:    false,
+	})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(0, 3, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(0, 4, 1)
+
+	fmt.Println(Closure(g))
+	reduction, err := Reduction(g)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(reduction)
+}
@@ -0,0 +1,121 @@
+package transitive
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/bitmatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func edges(g datastructures.Graph) string {
+	es := [][2]int{}
+	for u := 0; u < g.Size(); u++ {
+		for v := 0; v < g.Size(); v++ {
+			if g.Adjacent(u, v) {
+				es = append(es, [2]int{u, v})
+			}
+		}
+	}
+	return fmt.Sprint(es)
+}
+
+func countEdges(g datastructures.Graph) int {
+	total := 0
+	for u := 0; u < g.Size(); u++ {
+		total += len(g.Neighbors(u))
+	}
+	return total
+}
+
+// 0 -> 1 -> 3 -> 4, 0 -> 2 -> 3, plus the shortcuts 0 -> 3 and 0 -> 4
+var dag = []graphtest.Edge{{0, 1, 1}, {0, 2, 2}, {0, 3, 3}, {1, 3, 4}, {2, 3, 5}, {3, 4, 6}, {0, 4, 7}}
+
+func TestClosure(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		n     int
+		edges []graphtest.Edge
+		want  string
+	}{
+		{"dag", 5, dag, "[[0 1] [0 2] [0 3] [0 4] [1 3] [1 4] [2 3] [2 4] [3 4]]"},
+		{"cycle", 3, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}}, "[[0 0] [0 1] [0 2] [1 0] [1 1] [1 2] [2 0] [2 1] [2 2]]"},
+		{"disconnected", 4, []graphtest.Edge{{0, 1, 1}, {2, 3, 1}}, "[[0 1] [2 3]]"},
+	}
+
+	for _, tc := range testCases {
+		bm := bitmatrix.New(datastructures.Options{TotalVertices: uint32(tc.n)})
+		for _, e := range tc.edges {
+			bm.AddEdge(e[0], e[1], e[2])
+		}
+		for _, g := range append(graphtest.BuildEach(tc.n, false, tc.edges), bm) {
+			t.Run(fmt.Sprintf("%v on %v", tc.desc, g.Name()), func(t *testing.T) {
+				closure := Closure(g)
+				helpers.AssertEqual(t, closure.Name(), g.Name())
+				helpers.AssertEqual(t, edges(closure), tc.want)
+				helpers.AssertEqual(t, edges(Warshall(g)), tc.want)
+				helpers.AssertEqual(t, edges(ClosureBFS(g)), tc.want)
+			})
+		}
+	}
+}
+
+func TestReduction(t *testing.T) {
+	for _, g := range graphtest.BuildEach(5, false, dag) {
+		t.Run(g.Name(), func(t *testing.T) {
+			reduction, err := Reduction(g)
+			helpers.AssertEqual(t, err, nil)
+			helpers.AssertEqual(t, edges(reduction), "[[0 1] [0 2] [1 3] [2 3] [3 4]]")
+			w, _ := reduction.Weight(3, 4)
+			helpers.AssertEqual(t, w, 6)
+		})
+	}
+
+	t.Run("rejects cycles", func(t *testing.T) {
+		for _, g := range graphtest.BuildEach(3, false, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}, {2, 1, 1}}) {
+			_, err := Reduction(g)
+			helpers.Assert(t, err != nil)
+		}
+	})
+
+	t.Run("rejects self-loops", func(t *testing.T) {
+		for _, g := range graphtest.BuildEach(2, false, []graphtest.Edge{{0, 1, 1}, {1, 1, 1}}) {
+			_, err := Reduction(g)
+			helpers.Assert(t, err != nil)
+		}
+	})
+}
+
+func TestMinimumEquivalent(t *testing.T) {
+	// {0, 1, 2} and {3, 4} are cycles with extra chords, 5 hangs off both
+	cyclic := []graphtest.Edge{
+		{0, 1, 1}, {1, 2, 1}, {2, 0, 1}, {0, 2, 1},
+		{3, 4, 1}, {4, 3, 1},
+		{1, 3, 1}, {0, 4, 1}, {4, 5, 1}, {0, 5, 1},
+	}
+	for _, g := range graphtest.BuildEach(6, false, cyclic) {
+		t.Run(g.Name(), func(t *testing.T) {
+			meg := MinimumEquivalent(g)
+			helpers.AssertEqual(t, edges(Closure(meg)), edges(Closure(g)))
+			helpers.AssertEqual(t, countEdges(meg), 3+2+2)
+		})
+	}
+
+	t.Run("random graphs keep their reachability", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 20; trial++ {
+			n := 2 + rng.Intn(15)
+			g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n)})
+			for i := 0; i < 2*n; i++ {
+				g.AddEdge(rng.Intn(n), rng.Intn(n), 1)
+			}
+			meg := MinimumEquivalent(g)
+			helpers.AssertEqual(t, edges(Closure(meg)), edges(Closure(g)))
+			helpers.Assert(t, countEdges(meg) <= countEdges(g))
+		}
+	})
+}
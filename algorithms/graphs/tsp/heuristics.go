@@ -0,0 +1,232 @@
+package tsp
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/mst/prim"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// NearestNeighbor builds a tour from start by always moving to the closest
+// unvisited vertex. It runs in O(n^2) and fails if it gets stuck.
+func NearestNeighbor[W datastructures.Number](g datastructures.WeightedGraph[W], start int) (Tour, error) {
+	n := g.Size()
+	d := distances(g)
+	visited := make([]bool, n)
+	vertices := []int{start}
+	visited[start] = true
+	for u := start; len(vertices) < n; {
+		next := -1
+		for v := 0; v < n; v++ {
+			if !visited[v] && !math.IsInf(d[u][v], 1) && (next == -1 || d[u][v] < d[u][next]) {
+				next = v
+			}
+		}
+		if next == -1 {
+			return Tour{}, fmt.Errorf("error: no unvisited vertex is reachable from %v", u)
+		}
+		visited[next] = true
+		vertices = append(vertices, next)
+		u = next
+	}
+	cost := tourCost(d, vertices)
+	if math.IsInf(cost, 1) {
+		return Tour{}, fmt.Errorf("error: there is no edge back to %v", start)
+	}
+	return Tour{vertices, cost}, nil
+}
+
+// TwoOpt improves t by reversing sections of it for as long as that makes it
+// cheaper, and returns the result. A tour is 2-optimal when no pair of edges
+// can be swapped for a cheaper pair.
+func TwoOpt[W datastructures.Number](g datastructures.WeightedGraph[W], t Tour) Tour {
+	d := distances(g)
+	n := len(t.Vertices)
+	vertices := append([]int{}, t.Vertices...)
+
+	symmetric := true
+	for u := range d {
+		for v := range d {
+			symmetric = symmetric && d[u][v] == d[v][u]
+		}
+	}
+
+	// reversing vertices[i..j] replaces the edges (a, b) and (c, e) with
+	// (a, c) and (b, e)
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < n-1; i++ {
+			for j := i + 1; j < n; j++ {
+				a, b := vertices[i-1], vertices[i]
+				c, e := vertices[j], vertices[(j+1)%n]
+				delta := d[a][c] + d[b][e] - d[a][b] - d[c][e]
+				if !symmetric {
+					// the reversed section is now travelled the other way
+					for k := i; k < j; k++ {
+						delta += d[vertices[k+1]][vertices[k]] - d[vertices[k]][vertices[k+1]]
+					}
+				}
+				if delta < -1e-9 {
+					for l, r := i, j; l < r; l, r = l+1, r-1 {
+						vertices[l], vertices[r] = vertices[r], vertices[l]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+	return Tour{vertices, tourCost(d, vertices)}
+}
+
+// Christofides returns a tour at most 1.5 times longer than optimal on
+// complete undirected graphs whose weights obey the triangle inequality. It
+// joins a minimum spanning tree with a minimum-weight perfect matching on the
+// vertices of odd degree in the tree, walks an Euler circuit of the result,
+// and skips vertices already visited.
+//
+// The matching is exact up to MaxExact odd vertices and greedy beyond that,
+// where the 1.5 bound no longer holds.
+func Christofides[W datastructures.Number](g datastructures.WeightedGraph[W]) (Tour, error) {
+	n := g.Size()
+	if !g.Undirected() {
+		return Tour{}, fmt.Errorf("error: graph is directed")
+	}
+	if n < 3 {
+		vertices := []int{}
+		for v := 0; v < n; v++ {
+			vertices = append(vertices, v)
+		}
+		return Tour{vertices, tourCost(distances(g), vertices)}, nil
+	}
+	d := distances(g)
+	for u := range d {
+		for v := range d {
+			if u != v && math.IsInf(d[u][v], 1) {
+				return Tour{}, fmt.Errorf("error: graph is not complete, %v and %v are not adjacent", u, v)
+			}
+		}
+	}
+
+	// multigraph of tree and matching edges
+	adj := make([][]int, n)
+	link := func(u, v int) {
+		adj[u] = append(adj[u], v)
+		adj[v] = append(adj[v], u)
+	}
+	for _, v := range prim.MST(g) {
+		if v.Parent != nil {
+			link(v.Parent.Value, v.Value)
+		}
+	}
+	odd := []int{}
+	for v := range adj {
+		if len(adj[v])%2 == 1 {
+			odd = append(odd, v)
+		}
+	}
+	for _, pair := range perfectMatching(d, odd) {
+		link(pair[0], pair[1])
+	}
+
+	visited := make([]bool, n)
+	vertices := []int{}
+	for _, v := range eulerCircuit(adj, 0) {
+		if !visited[v] {
+			visited[v] = true
+			vertices = append(vertices, v)
+		}
+	}
+	return Tour{vertices, tourCost(d, vertices)}, nil
+}
+
+// perfectMatching pairs up vs, an even number of vertices, with minimum total
+// weight. It uses dynamic programming over subsets, always matching the lowest
+// unmatched vertex, when there are at most MaxExact of them, and otherwise
+// greedily takes the cheapest remaining pair.
+func perfectMatching(d [][]float64, vs []int) [][2]int {
+	m := len(vs)
+	pairs := [][2]int{}
+	if m > MaxExact {
+		matched := make([]bool, m)
+		for k := 0; k < m/2; k++ {
+			bi, bj := -1, -1
+			for i := 0; i < m; i++ {
+				for j := i + 1; j < m; j++ {
+					if !matched[i] && !matched[j] && (bi == -1 || d[vs[i]][vs[j]] < d[vs[bi]][vs[bj]]) {
+						bi, bj = i, j
+					}
+				}
+			}
+			matched[bi], matched[bj] = true, true
+			pairs = append(pairs, [2]int{vs[bi], vs[bj]})
+		}
+		return pairs
+	}
+
+	// best[S] is the cheapest matching of the vertices in S
+	best := make([]float64, 1<<m)
+	for s := 1; s < 1<<m; s++ {
+		best[s] = math.Inf(1)
+		i := lowest(s)
+		for j := i + 1; j < m; j++ {
+			if s>>j&1 == 1 {
+				best[s] = math.Min(best[s], best[s&^(1<<i|1<<j)]+d[vs[i]][vs[j]])
+			}
+		}
+	}
+	for s := 1<<m - 1; s != 0; {
+		i := lowest(s)
+		for j := i + 1; j < m; j++ {
+			rest := s &^ (1<<i | 1<<j)
+			if s>>j&1 == 1 && best[rest]+d[vs[i]][vs[j]] == best[s] {
+				pairs = append(pairs, [2]int{vs[i], vs[j]})
+				s = rest
+				break
+			}
+		}
+	}
+	return pairs
+}
+
+func lowest(s int) int {
+	i := 0
+	for s>>i&1 == 0 {
+		i++
+	}
+	return i
+}
+
+// eulerCircuit walks every edge of the connected multigraph adj, in which all
+// degrees are even, exactly once with Hierholzer's algorithm.
+func eulerCircuit(adj [][]int, start int) []int {
+	remaining := make([][]int, len(adj))
+	for u := range adj {
+		remaining[u] = append([]int{}, adj[u]...)
+	}
+	// remove deletes one copy of the edge (u, v) from the list of u
+	remove := func(u, v int) {
+		for i, w := range remaining[u] {
+			if w == v {
+				remaining[u] = append(remaining[u][:i], remaining[u][i+1:]...)
+				return
+			}
+		}
+	}
+
+	circuit := []int{}
+	stack := []int{start}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		if len(remaining[u]) == 0 {
+			circuit = append(circuit, u)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		v := remaining[u][0]
+		remove(u, v)
+		remove(v, u)
+		stack = append(stack, v)
+	}
+	return circuit
+}
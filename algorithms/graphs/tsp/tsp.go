@@ -0,0 +1,228 @@
+package tsp
+
+import (
+	"fmt"
+	"math"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+)
+
+// The travelling salesman problem asks for the cheapest cycle visiting every
+// vertex exactly once. The solvers read edge weights as distances and are
+// meant for adjacency matrices, where looking a weight up is O(1). A missing
+// edge is infinitely long.
+
+// MaxExact is the largest number of vertices the exact solvers accept. Their
+// tables have 2^(n-1) rows.
+const MaxExact = 20
+
+// Tour is a cycle through every vertex. Vertices lists each vertex once,
+// starting from the first, and Cost includes the edge back to the start.
+type Tour struct {
+	Vertices []int
+	Cost     float64
+}
+
+func (t Tour) String() string {
+	return fmt.Sprintf("%v (%v)", t.Vertices, t.Cost)
+}
+
+// distances reads the weight of every edge of g into a matrix.
+func distances[W datastructures.Number](g datastructures.WeightedGraph[W]) [][]float64 {
+	n := g.Size()
+	d := make([][]float64, n)
+	for u := range d {
+		d[u] = make([]float64, n)
+		for v := range d[u] {
+			d[u][v] = math.Inf(1)
+		}
+		for _, v := range g.Neighbors(u) {
+			w, _ := g.Weight(u, v)
+			d[u][v] = float64(w)
+		}
+	}
+	return d
+}
+
+func tourCost(d [][]float64, vertices []int) float64 {
+	total := 0.0
+	for i, u := range vertices {
+		total += d[u][vertices[(i+1)%len(vertices)]]
+	}
+	return total
+}
+
+// HeldKarp returns an optimal tour in O(2^n n^2) time with dynamic
+// programming over subsets: best[S][j] is the cheapest path that starts at
+// vertex 0, visits exactly the vertices in S and ends at j. It fails if g has
+// more than MaxExact vertices or no tour exists.
+func HeldKarp[W datastructures.Number](g datastructures.WeightedGraph[W]) (Tour, error) {
+	n := g.Size()
+	if n > MaxExact {
+		return Tour{}, fmt.Errorf("error: %v vertices is more than the %v an exact solver accepts", n, MaxExact)
+	}
+	if n == 0 {
+		return Tour{[]int{}, 0}, nil
+	}
+	d := distances(g)
+	if n == 1 {
+		return Tour{[]int{0}, 0}, nil
+	}
+
+	// subsets range over vertices 1..n-1, vertex v is bit v-1
+	m := n - 1
+	full := 1<<m - 1
+	best := make([][]float64, 1<<m)
+	for s := range best {
+		best[s] = make([]float64, m)
+		for j := range best[s] {
+			best[s][j] = math.Inf(1)
+		}
+	}
+	for j := 0; j < m; j++ {
+		best[1<<j][j] = d[0][j+1]
+	}
+	for s := 1; s <= full; s++ {
+		for j := 0; j < m; j++ {
+			if s>>j&1 == 0 || math.IsInf(best[s][j], 1) {
+				continue
+			}
+			for k := 0; k < m; k++ {
+				if s>>k&1 == 1 {
+					continue
+				}
+				if c := best[s][j] + d[j+1][k+1]; c < best[s|1<<k][k] {
+					best[s|1<<k][k] = c
+				}
+			}
+		}
+	}
+
+	last, cost := -1, math.Inf(1)
+	for j := 0; j < m; j++ {
+		if c := best[full][j] + d[j+1][0]; c < cost {
+			last, cost = j, c
+		}
+	}
+	if last == -1 {
+		return Tour{}, fmt.Errorf("error: graph has no hamiltonian cycle")
+	}
+
+	// walk back through the table to recover the order
+	vertices := make([]int, n)
+	for s, j, i := full, last, n-1; i > 0; i-- {
+		vertices[i] = j + 1
+		prev := s &^ (1 << j)
+		if prev == 0 {
+			break
+		}
+		for k := 0; k < m; k++ {
+			if prev>>k&1 == 1 && best[prev][k]+d[k+1][j+1] == best[s][j] {
+				s, j = prev, k
+				break
+			}
+		}
+	}
+	return Tour{vertices, cost}, nil
+}
+
+// HamiltonianPath returns a path visiting every vertex of g exactly once, or
+// false if there is none. Only adjacency matters, not weights. It fails if g
+// has more than MaxExact vertices.
+func HamiltonianPath(g datastructures.Topology) ([]int, bool, error) {
+	return hamiltonian(g, false)
+}
+
+// HamiltonianCycle returns a cycle visiting every vertex of g exactly once,
+// listing each vertex once, or false if there is none. It fails if g has more
+// than MaxExact vertices.
+func HamiltonianCycle(g datastructures.Topology) ([]int, bool, error) {
+	return hamiltonian(g, true)
+}
+
+// hamiltonian computes ends[S], the set of vertices at which some path through
+// exactly the vertices in S can end, as a bitmask. Cycles must start at 0.
+func hamiltonian(g datastructures.Topology, cycle bool) ([]int, bool, error) {
+	n := g.Size()
+	if n > MaxExact {
+		return nil, false, fmt.Errorf("error: %v vertices is more than the %v an exact solver accepts", n, MaxExact)
+	}
+	if n == 0 {
+		return []int{}, true, nil
+	}
+
+	adj := make([]int, n) // bitmask of the successors of each vertex
+	for u := 0; u < n; u++ {
+		for _, v := range g.Neighbors(u) {
+			adj[u] |= 1 << v
+		}
+	}
+
+	full := 1<<n - 1
+	ends := make([]int, 1<<n)
+	for v := 0; v < n; v++ {
+		if !cycle || v == 0 {
+			ends[1<<v] = 1 << v
+		}
+	}
+	for s := 1; s <= full; s++ {
+		for j := 0; j < n; j++ {
+			if ends[s]>>j&1 == 0 {
+				continue
+			}
+			next := adj[j] &^ s
+			for k := 0; k < n; k++ {
+				if next>>k&1 == 1 {
+					ends[s|1<<k] |= 1 << k
+				}
+			}
+		}
+	}
+
+	last := -1
+	for j := 0; j < n; j++ {
+		if ends[full]>>j&1 == 1 && (!cycle || n == 1 || adj[j]>>0&1 == 1) {
+			last = j
+			break
+		}
+	}
+	if last == -1 {
+		return nil, false, nil
+	}
+
+	path := make([]int, n)
+	for s, j, i := full, last, n-1; i >= 0; i-- {
+		path[i] = j
+		prev := s &^ (1 << j)
+		for k := 0; k < n; k++ {
+			if ends[prev]>>k&1 == 1 && adj[k]>>j&1 == 1 {
+				s, j = prev, k
+				break
+			}
+		}
+	}
+	return path, true, nil
+}
+
+func Demo() {
+	g := adjacencymatrix.New(datastructures.Options{
+		TotalVertices: 4,
+		Undirected:    true,
+	})
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(0, 2, 15)
+	g.AddEdge(0, 3, 20)
+	g.AddEdge(1, 2, 35)
+	g.AddEdge(1, 3, 25)
+	g.AddEdge(2, 3, 30)
+
+	fmt.Println(HeldKarp(g))
+	tour, err := NearestNeighbor(g, 0)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(tour, TwoOpt(g, tour))
+	fmt.Println(Christofides(g))
+}
@@ -0,0 +1,211 @@
+package tsp
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// points returns the complete graph on random grid points with Manhattan
+// distances, which obey the triangle inequality.
+func points(rng *rand.Rand, n int) datastructures.Graph {
+	g := adjacencymatrix.New(datastructures.Options{TotalVertices: uint32(n), Undirected: true})
+	xs, ys := make([]int, n), make([]int, n)
+	for v := 0; v < n; v++ {
+		xs[v], ys[v] = rng.Intn(100), rng.Intn(100)
+	}
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			dx, dy := xs[u]-xs[v], ys[u]-ys[v]
+			// keep coincident points adjacent, as 0 means no edge
+			g.AddEdge(u, v, max(1, abs(dx)+abs(dy)))
+		}
+	}
+	return g
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// bruteForce tries every ordering of the vertices after 0.
+func bruteForce(g datastructures.Graph) float64 {
+	d := distances(g)
+	n := g.Size()
+	best := math.Inf(1)
+	perm := []int{0}
+	used := make([]bool, n)
+	used[0] = true
+	var visit func()
+	visit = func() {
+		if len(perm) == n {
+			best = math.Min(best, tourCost(d, perm))
+			return
+		}
+		for v := 1; v < n; v++ {
+			if !used[v] {
+				used[v] = true
+				perm = append(perm, v)
+				visit()
+				perm = perm[:len(perm)-1]
+				used[v] = false
+			}
+		}
+	}
+	visit()
+	return best
+}
+
+// assertTour checks that t visits every vertex once and costs what it says.
+func assertTour(t *testing.T, g datastructures.Graph, tour Tour) {
+	t.Helper()
+	helpers.AssertEqual(t, len(tour.Vertices), g.Size())
+	seen := make([]bool, g.Size())
+	for _, v := range tour.Vertices {
+		helpers.Assert(t, !seen[v])
+		seen[v] = true
+	}
+	helpers.AssertInDelta(t, tour.Cost, tourCost(distances(g), tour.Vertices), 1e-9)
+}
+
+func example() datastructures.Graph {
+	g := adjacencymatrix.New(datastructures.Options{TotalVertices: 4, Undirected: true})
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(0, 2, 15)
+	g.AddEdge(0, 3, 20)
+	g.AddEdge(1, 2, 35)
+	g.AddEdge(1, 3, 25)
+	g.AddEdge(2, 3, 30)
+	return g
+}
+
+func TestHeldKarp(t *testing.T) {
+	tour, err := HeldKarp(example())
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, tour.Cost, 80.0)
+	assertTour(t, example(), tour)
+
+	t.Run("directed", func(t *testing.T) {
+		g := adjacencymatrix.New(datastructures.Options{TotalVertices: 3})
+		g.AddEdge(0, 1, 1)
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(2, 0, 1)
+		g.AddEdge(0, 2, 5)
+		g.AddEdge(2, 1, 5)
+		g.AddEdge(1, 0, 5)
+		tour, err := HeldKarp(g)
+		helpers.AssertEqual(t, err, nil)
+		helpers.AssertEqual(t, helpers.ToString(tour.Vertices), "[0 1 2]")
+		helpers.AssertEqual(t, tour.Cost, 3.0)
+	})
+
+	t.Run("no tour", func(t *testing.T) {
+		g := adjacencymatrix.New(datastructures.Options{TotalVertices: 3, Undirected: true})
+		g.AddEdge(0, 1, 1)
+		g.AddEdge(1, 2, 1)
+		_, err := HeldKarp(g)
+		helpers.Assert(t, err != nil)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := HeldKarp(adjacencymatrix.New(datastructures.Options{TotalVertices: MaxExact + 1}))
+		helpers.Assert(t, err != nil)
+	})
+
+	t.Run("random points", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 20; trial++ {
+			g := points(rng, 2+rng.Intn(7))
+			tour, err := HeldKarp(g)
+			helpers.AssertEqual(t, err, nil)
+			assertTour(t, g, tour)
+			helpers.AssertEqual(t, tour.Cost, bruteForce(g))
+		}
+	})
+}
+
+func TestHamiltonian(t *testing.T) {
+	// a path 0 - 1 - 2 - 3 with the chord 0 - 2
+	g := adjacencymatrix.New(datastructures.Options{TotalVertices: 4, Undirected: true})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(0, 2, 1)
+
+	path, ok, err := HamiltonianPath(g)
+	helpers.AssertEqual(t, err, nil)
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, len(path), 4)
+	for i := 1; i < len(path); i++ {
+		helpers.Assert(t, g.Adjacent(path[i-1], path[i]))
+	}
+
+	_, ok, _ = HamiltonianCycle(g)
+	helpers.Assert(t, !ok)
+
+	g.AddEdge(3, 0, 1)
+	cycle, ok, _ := HamiltonianCycle(g)
+	helpers.Assert(t, ok)
+	for i := range cycle {
+		helpers.Assert(t, g.Adjacent(cycle[i], cycle[(i+1)%len(cycle)]))
+	}
+
+	t.Run("directed", func(t *testing.T) {
+		g := adjacencymatrix.New(datastructures.Options{TotalVertices: 3})
+		g.AddEdge(2, 1, 1)
+		g.AddEdge(1, 0, 1)
+		path, ok, _ := HamiltonianPath(g)
+		helpers.Assert(t, ok)
+		helpers.AssertEqual(t, helpers.ToString(path), "[2 1 0]")
+		_, ok, _ = HamiltonianCycle(g)
+		helpers.Assert(t, !ok)
+	})
+}
+
+func TestHeuristics(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 20; trial++ {
+		g := points(rng, 3+rng.Intn(7))
+		optimal := bruteForce(g)
+
+		nn, err := NearestNeighbor(g, 0)
+		helpers.AssertEqual(t, err, nil)
+		assertTour(t, g, nn)
+
+		improved := TwoOpt(g, nn)
+		assertTour(t, g, improved)
+		helpers.Assert(t, improved.Cost <= nn.Cost)
+		helpers.Assert(t, improved.Cost >= optimal)
+
+		christofides, err := Christofides(g)
+		helpers.AssertEqual(t, err, nil)
+		assertTour(t, g, christofides)
+		helpers.Assert(t, christofides.Cost <= 1.5*optimal+1e-9)
+	}
+
+	t.Run("larger instance", func(t *testing.T) {
+		g := points(rng, 60)
+		nn, _ := NearestNeighbor(g, 0)
+		improved := TwoOpt(g, nn)
+		assertTour(t, g, improved)
+		christofides, err := Christofides(g)
+		helpers.AssertEqual(t, err, nil)
+		assertTour(t, g, christofides)
+	})
+
+	t.Run("stuck", func(t *testing.T) {
+		g := adjacencymatrix.New(datastructures.Options{TotalVertices: 3, Undirected: true})
+		g.AddEdge(0, 1, 1)
+		_, err := NearestNeighbor(g, 0)
+		helpers.Assert(t, err != nil)
+		_, err = Christofides(g)
+		helpers.Assert(t, err != nil)
+	})
+}
@@ -0,0 +1,177 @@
+package twosat
+
+import (
+	"fmt"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/queues/linkedlistqueue"
+)
+
+// 2-SAT decides whether a conjunction of clauses, each the disjunction of at
+// most two literals, can be satisfied.
+//
+// Every clause (a ∨ b) is equivalent to the implications ¬a → b and ¬b → a.
+// The formula is unsatisfiable exactly when some variable x and its negation
+// lie in the same strongly connected component of the implication graph, as
+// then x → ¬x and ¬x → x.
+
+// Literal is a boolean variable or its negation.
+type Literal struct {
+	Var     int
+	Negated bool
+}
+
+// Var returns the literal that is true when variable v is true.
+func Var(v int) Literal {
+	return Literal{v, false}
+}
+
+// Not returns the literal that is true when variable v is false.
+func Not(v int) Literal {
+	return Literal{v, true}
+}
+
+func (l Literal) Negate() Literal {
+	return Literal{l.Var, !l.Negated}
+}
+
+func (l Literal) String() string {
+	if l.Negated {
+		return fmt.Sprintf("¬x%v", l.Var)
+	}
+	return fmt.Sprintf("x%v", l.Var)
+}
+
+// vertex numbers literals in the implication graph: x is 2x and ¬x is 2x+1.
+func (l Literal) vertex() int {
+	if l.Negated {
+		return 2*l.Var + 1
+	}
+	return 2 * l.Var
+}
+
+func literal(vertex int) Literal {
+	return Literal{vertex / 2, vertex%2 == 1}
+}
+
+type Formula struct {
+	variables int
+	graph     datastructures.Graph // implication graph
+}
+
+// New returns an empty formula over the variables 0..variables-1.
+func New(variables int) *Formula {
+	return &Formula{
+		variables: variables,
+		graph: adjacencylist.New(datastructures.Options{
+			TotalVertices: uint32(2 * variables),
+			Undirected:    false,
+		}),
+	}
+}
+
+// AddClause adds the clause (a ∨ b).
+func (f *Formula) AddClause(a, b Literal) {
+	f.graph.AddEdge(a.Negate().vertex(), b.vertex(), 1)
+	f.graph.AddEdge(b.Negate().vertex(), a.vertex(), 1)
+}
+
+// Require adds the clause (a), forcing a to be true.
+func (f *Formula) Require(a Literal) {
+	f.AddClause(a, a)
+}
+
+// Implies adds the clause (a → b).
+func (f *Formula) Implies(a, b Literal) {
+	f.AddClause(a.Negate(), b)
+}
+
+// AtMostOne adds the clause ¬(a ∧ b).
+func (f *Formula) AtMostOne(a, b Literal) {
+	f.AddClause(a.Negate(), b.Negate())
+}
+
+// ExactlyOne adds the clauses (a ∨ b) and ¬(a ∧ b).
+func (f *Formula) ExactlyOne(a, b Literal) {
+	f.AddClause(a, b)
+	f.AtMostOne(a, b)
+}
+
+// Solve returns a satisfying assignment indexed by variable. If there is none,
+// it returns instead a core of conflicting literals: a cycle of implications
+// x → ... → ¬x → ... → x, starting and ending at x.
+func (f *Formula) Solve() (assignment []bool, core []Literal, ok bool) {
+	_, component := scc.Condensation(f.graph)
+
+	assignment = make([]bool, f.variables)
+	for v := 0; v < f.variables; v++ {
+		pos, neg := component[Var(v).vertex()], component[Not(v).vertex()]
+		if pos == neg {
+			return nil, f.conflict(v), false
+		}
+		// components are numbered in topological order, so x is implied by
+		// ¬x rather than the other way round when x comes later
+		assignment[v] = pos > neg
+	}
+	return assignment, nil, true
+}
+
+// Satisfies reports whether assignment satisfies every clause.
+func (f *Formula) Satisfies(assignment []bool) bool {
+	value := func(l Literal) bool {
+		return assignment[l.Var] != l.Negated
+	}
+	for u := 0; u < f.graph.Size(); u++ {
+		for _, v := range f.graph.Neighbors(u) {
+			// ¬a → b holds unless ¬a is true and b is false
+			if value(literal(u)) && !value(literal(v)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// conflict returns the cycle x → ... → ¬x → ... → x.
+func (f *Formula) conflict(v int) []Literal {
+	there := f.path(Var(v).vertex(), Not(v).vertex())
+	back := f.path(Not(v).vertex(), Var(v).vertex())
+	core := []Literal{}
+	for _, u := range append(there, back[1:]...) {
+		core = append(core, literal(u))
+	}
+	return core
+}
+
+// path returns the vertices on a shortest path from src to dst.
+func (f *Formula) path(src, dst int) []int {
+	from := map[int]int{src: -1}
+	q := linkedlistqueue.New(src)
+	for u, ok := q.Dequeue(); ok && u != dst; u, ok = q.Dequeue() {
+		for _, v := range f.graph.Neighbors(u) {
+			if _, seen := from[v]; !seen {
+				from[v] = u
+				q.Enqueue(v)
+			}
+		}
+	}
+	path := []int{}
+	for u := dst; u != -1; u = from[u] {
+		path = append([]int{u}, path...)
+	}
+	return path
+}
+
+func Demo() {
+	// feature flags: 0 = cache, 1 = compression, 2 = legacy mode
+	f := New(3)
+	f.Implies(Var(1), Var(0))   // compression needs the cache
+	f.AtMostOne(Var(0), Var(2)) // legacy mode cannot use the cache
+	f.Require(Var(1))           // compression is mandatory
+	fmt.Println(f.Solve())
+
+	f.Require(Var(2)) // ...and so is legacy mode
+	fmt.Println(f.Solve())
+}
@@ -0,0 +1,108 @@
+package twosat
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// assertCore checks that core is a cycle of implications through a variable
+// and its negation.
+func assertCore(t *testing.T, f *Formula, core []Literal) {
+	t.Helper()
+	helpers.Assert(t, len(core) >= 3)
+	helpers.AssertEqual(t, core[0], core[len(core)-1])
+	negated := false
+	for i := 1; i < len(core); i++ {
+		helpers.Assert(t, f.graph.Adjacent(core[i-1].vertex(), core[i].vertex()))
+		negated = negated || core[i] == core[0].Negate()
+	}
+	helpers.Assert(t, negated)
+}
+
+func TestSolve(t *testing.T) {
+	t.Run("satisfiable", func(t *testing.T) {
+		f := New(3)
+		f.Implies(Var(1), Var(0))
+		f.AtMostOne(Var(0), Var(2))
+		f.Require(Var(1))
+		assignment, core, ok := f.Solve()
+		helpers.Assert(t, ok)
+		helpers.AssertEqual(t, len(core), 0)
+		helpers.AssertEqual(t, helpers.ToString(assignment), "[true true false]")
+	})
+
+	t.Run("contradiction", func(t *testing.T) {
+		f := New(1)
+		f.Require(Var(0))
+		f.Require(Not(0))
+		_, core, ok := f.Solve()
+		helpers.Assert(t, !ok)
+		assertCore(t, f, core)
+		helpers.AssertEqual(t, helpers.ToString(core), "[x0 ¬x0 x0]")
+	})
+
+	t.Run("conflict through a chain", func(t *testing.T) {
+		f := New(4)
+		f.Implies(Var(0), Var(1))
+		f.Implies(Var(1), Var(2))
+		f.Implies(Var(2), Not(0))
+		f.Implies(Not(0), Var(3))
+		f.Implies(Var(3), Var(0))
+		_, core, ok := f.Solve()
+		helpers.Assert(t, !ok)
+		assertCore(t, f, core)
+	})
+
+	t.Run("no clauses", func(t *testing.T) {
+		_, _, ok := New(5).Solve()
+		helpers.Assert(t, ok)
+	})
+}
+
+// bruteForce tries every assignment.
+func bruteForce(variables int, clauses [][2]Literal) bool {
+	for mask := 0; mask < 1<<variables; mask++ {
+		satisfied := true
+		for _, c := range clauses {
+			a := (mask>>c[0].Var)&1 == 1 != c[0].Negated
+			b := (mask>>c[1].Var)&1 == 1 != c[1].Negated
+			if !a && !b {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSolveRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := func(variables int) Literal {
+		return Literal{rng.Intn(variables), rng.Intn(2) == 1}
+	}
+	for trial := 0; trial < 200; trial++ {
+		variables := 1 + rng.Intn(8)
+		clauses := [][2]Literal{}
+		f := New(variables)
+		for i := 0; i < rng.Intn(4*variables); i++ {
+			a, b := random(variables), random(variables)
+			clauses = append(clauses, [2]Literal{a, b})
+			f.AddClause(a, b)
+		}
+		t.Run(fmt.Sprint(trial), func(t *testing.T) {
+			assignment, core, ok := f.Solve()
+			helpers.AssertEqual(t, ok, bruteForce(variables, clauses))
+			if ok {
+				helpers.Assert(t, f.Satisfies(assignment))
+			} else {
+				assertCore(t, f, core)
+			}
+		})
+	}
+}
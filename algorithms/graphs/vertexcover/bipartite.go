@@ -0,0 +1,152 @@
+package vertexcover
+
+import (
+	"fmt"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/queues/linkedlistqueue"
+)
+
+// sides 2-colours the graph, so that every edge joins a left vertex to a right
+// one. It fails if the graph has an odd cycle.
+func (net *network) sides() ([]bool, error) {
+	n := len(net.nbrs)
+	colored := make([]bool, n)
+	left := make([]bool, n)
+	for s := range net.nbrs {
+		if net.loops[s] {
+			return nil, fmt.Errorf("error: graph has a self-loop on %v", s)
+		}
+		if colored[s] {
+			continue
+		}
+		colored[s] = true
+		left[s] = true
+		q := linkedlistqueue.New(s)
+		for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+			for _, v := range net.nbrs[u] {
+				if !colored[v] {
+					colored[v] = true
+					left[v] = !left[u]
+					q.Enqueue(v)
+				} else if left[v] == left[u] {
+					return nil, fmt.Errorf("error: graph is not bipartite, %v and %v are on the same side", u, v)
+				}
+			}
+		}
+	}
+	return left, nil
+}
+
+// matching finds a maximum matching with Hopcroft-Karp in O(E sqrt(V)). mate
+// holds the vertex each vertex is matched to, or -1.
+func (net *network) matching(left []bool) []int {
+	n := len(net.nbrs)
+	mate := make([]int, n)
+	for v := range mate {
+		mate[v] = -1
+	}
+	const unreached = -1
+	layer := make([]int, n)
+
+	// bfs layers the left vertices by alternating path length from the free
+	// left vertices and reports whether a free right vertex can be reached
+	bfs := func() bool {
+		q := linkedlistqueue.New[int]()
+		for u := range net.nbrs {
+			layer[u] = unreached
+			if left[u] && mate[u] == -1 {
+				layer[u] = 0
+				q.Enqueue(u)
+			}
+		}
+		found := false
+		for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+			for _, v := range net.nbrs[u] {
+				w := mate[v]
+				if w == -1 {
+					found = true
+				} else if layer[w] == unreached {
+					layer[w] = layer[u] + 1
+					q.Enqueue(w)
+				}
+			}
+		}
+		return found
+	}
+
+	// dfs augments along a shortest alternating path from the left vertex u
+	var dfs func(u int) bool
+	dfs = func(u int) bool {
+		for _, v := range net.nbrs[u] {
+			w := mate[v]
+			if w == -1 || layer[w] == layer[u]+1 && dfs(w) {
+				mate[u], mate[v] = v, u
+				return true
+			}
+		}
+		layer[u] = unreached
+		return false
+	}
+
+	for bfs() {
+		for u := range net.nbrs {
+			if left[u] && mate[u] == -1 {
+				dfs(u)
+			}
+		}
+	}
+	return mate
+}
+
+// BipartiteMinVertexCover returns a smallest vertex cover of the bipartite
+// graph g, sorted. By König's theorem it is as large as a maximum matching,
+// which Hopcroft-Karp finds in O(E sqrt(V)). It fails if g is not bipartite.
+//
+// Let Z be the vertices reachable from unmatched left vertices along
+// alternating paths. The cover is the left vertices outside Z and the right
+// vertices inside Z.
+func BipartiteMinVertexCover(g datastructures.Topology) ([]int, error) {
+	net := newNetwork(g)
+	left, err := net.sides()
+	if err != nil {
+		return nil, err
+	}
+	mate := net.matching(left)
+
+	z := make([]bool, len(net.nbrs))
+	q := linkedlistqueue.New[int]()
+	for u := range net.nbrs {
+		if left[u] && mate[u] == -1 {
+			z[u] = true
+			q.Enqueue(u)
+		}
+	}
+	for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+		// from the left along unmatched edges, from the right along matched ones
+		for _, v := range net.nbrs[u] {
+			if !z[v] && (left[u] && mate[u] != v || !left[u] && mate[u] == v) {
+				z[v] = true
+				q.Enqueue(v)
+			}
+		}
+	}
+
+	cover := []int{}
+	for v := range net.nbrs {
+		if left[v] != z[v] {
+			cover = append(cover, v)
+		}
+	}
+	return cover, nil
+}
+
+// BipartiteMaxIndependentSet returns a largest independent set of the
+// bipartite graph g, sorted. It fails if g is not bipartite.
+func BipartiteMaxIndependentSet(g datastructures.Topology) ([]int, error) {
+	cover, err := BipartiteMinVertexCover(g)
+	if err != nil {
+		return nil, err
+	}
+	return complement(g.Size(), cover), nil
+}
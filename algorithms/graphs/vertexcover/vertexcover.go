@@ -0,0 +1,263 @@
+package vertexcover
+
+import (
+	"fmt"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/queues/linkedlistqueue"
+	"github.com/mhrdini/godsa/datastructures/utils/comparator"
+	"github.com/mhrdini/godsa/datastructures/utils/sorter"
+)
+
+// An independent set is a set of vertices no two of which are adjacent, and a
+// vertex cover is a set of vertices touching every edge. A set is independent
+// exactly when the remaining vertices form a cover, so a maximum independent
+// set and a minimum vertex cover are complements of each other.
+//
+// Both problems are NP-hard in general, so the exact solvers are only meant
+// for small graphs. Forests and bipartite graphs have fast dedicated solvers.
+// Edge directions are ignored. A vertex with a self-loop cannot be
+// independent.
+
+type set map[int]bool
+
+// network is g with edge directions dropped. Neighbour lists are sorted so
+// that results do not depend on map iteration order.
+type network struct {
+	adj   []set
+	nbrs  [][]int
+	loops []bool
+}
+
+func newNetwork(g datastructures.Topology) *network {
+	adj, loops := adjacency(g)
+	nbrs := make([][]int, len(adj))
+	for u := range adj {
+		for v := range adj[u] {
+			nbrs[u] = append(nbrs[u], v)
+		}
+		sorter.Sort(nbrs[u], comparator.OrderedComparator[int])
+	}
+	return &network{adj, nbrs, loops}
+}
+
+func adjacency(g datastructures.Topology) ([]set, []bool) {
+	adj := make([]set, g.Size())
+	for u := range adj {
+		adj[u] = set{}
+	}
+	loops := make([]bool, g.Size())
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			if u == v {
+				loops[u] = true
+				continue
+			}
+			adj[u][v] = true
+			adj[v][u] = true
+		}
+	}
+	return adj, loops
+}
+
+// complement returns the vertices of an n-vertex graph that are not in vs.
+func complement(n int, vs []int) []int {
+	in := make([]bool, n)
+	for _, v := range vs {
+		in[v] = true
+	}
+	result := []int{}
+	for v := 0; v < n; v++ {
+		if !in[v] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// MaxIndependentSet returns a largest independent set of g, sorted. It
+// repeatedly takes vertices of degree 0 or 1, which is always safe, and
+// otherwise branches on a vertex of maximum degree: either it is in the set
+// and its neighbours are not, or it is not.
+func MaxIndependentSet(g datastructures.Topology) []int {
+	adj, loops := adjacency(g)
+	alive := set{}
+	for v := range adj {
+		if !loops[v] {
+			alive[v] = true
+		}
+	}
+	in := make([]bool, g.Size())
+	for _, v := range maxIndependentSet(adj, alive) {
+		in[v] = true
+	}
+	result := []int{}
+	for v, ok := range in {
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func maxIndependentSet(adj []set, alive set) []int {
+	taken := []int{}
+	for {
+		if len(alive) == 0 {
+			return taken
+		}
+
+		// degree within the alive vertices; ties go to the smallest vertex
+		low, high := -1, -1
+		degree := map[int]int{}
+		for v := range alive {
+			for u := range adj[v] {
+				if alive[u] {
+					degree[v]++
+				}
+			}
+			if low == -1 || degree[v] < degree[low] || degree[v] == degree[low] && v < low {
+				low = v
+			}
+			if high == -1 || degree[v] > degree[high] || degree[v] == degree[high] && v < high {
+				high = v
+			}
+		}
+
+		if degree[low] <= 1 {
+			taken = append(taken, low)
+			alive = without(adj, alive, low, true)
+			continue
+		}
+
+		with := append([]int{high}, maxIndependentSet(adj, without(adj, alive, high, true))...)
+		rest := maxIndependentSet(adj, without(adj, alive, high, false))
+		if len(rest) > len(with) {
+			return append(taken, rest...)
+		}
+		return append(taken, with...)
+	}
+}
+
+// without returns a copy of alive without v and, if neighbours is set, without
+// the neighbours of v.
+func without(adj []set, alive set, v int, neighbours bool) set {
+	result := set{}
+	for u := range alive {
+		if u != v && !(neighbours && adj[v][u]) {
+			result[u] = true
+		}
+	}
+	return result
+}
+
+// MinVertexCover returns a smallest vertex cover of g, sorted.
+func MinVertexCover(g datastructures.Topology) []int {
+	return complement(g.Size(), MaxIndependentSet(g))
+}
+
+// ForestMaxIndependentSet returns a largest independent set of the forest g,
+// sorted, in O(V + E). A leaf is always in some largest independent set, so it
+// is taken and its parent discarded, until no vertices are left. It fails if g
+// has a cycle.
+func ForestMaxIndependentSet(g datastructures.Topology) ([]int, error) {
+	net := newNetwork(g)
+	n := g.Size()
+	edges := 0
+	for v := range net.nbrs {
+		if net.loops[v] {
+			return nil, fmt.Errorf("error: graph has a self-loop on %v", v)
+		}
+		edges += len(net.nbrs[v])
+	}
+	if net.components() != n-edges/2 {
+		return nil, fmt.Errorf("error: graph is not a forest")
+	}
+
+	degree := make([]int, n)
+	removed := make([]bool, n)
+	in := make([]bool, n)
+	q := linkedlistqueue.New[int]()
+	for v := range net.nbrs {
+		degree[v] = len(net.nbrs[v])
+		if degree[v] <= 1 {
+			q.Enqueue(v)
+		}
+	}
+	for v, ok := q.Dequeue(); ok; v, ok = q.Dequeue() {
+		if removed[v] {
+			continue
+		}
+		in[v] = true
+		removed[v] = true
+		for _, parent := range net.nbrs[v] {
+			if removed[parent] {
+				continue
+			}
+			removed[parent] = true
+			for _, u := range net.nbrs[parent] {
+				if !removed[u] {
+					if degree[u]--; degree[u] == 1 {
+						q.Enqueue(u)
+					}
+				}
+			}
+		}
+	}
+
+	result := []int{}
+	for v, ok := range in {
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// ForestMinVertexCover returns a smallest vertex cover of the forest g, sorted,
+// in O(V + E). It fails if g has a cycle.
+func ForestMinVertexCover(g datastructures.Topology) ([]int, error) {
+	mis, err := ForestMaxIndependentSet(g)
+	if err != nil {
+		return nil, err
+	}
+	return complement(g.Size(), mis), nil
+}
+
+func (net *network) components() int {
+	visited := make([]bool, len(net.nbrs))
+	count := 0
+	for s := range net.nbrs {
+		if visited[s] {
+			continue
+		}
+		count++
+		visited[s] = true
+		q := linkedlistqueue.New(s)
+		for u, ok := q.Dequeue(); ok; u, ok = q.Dequeue() {
+			for _, v := range net.nbrs[u] {
+				if !visited[v] {
+					visited[v] = true
+					q.Enqueue(v)
+				}
+			}
+		}
+	}
+	return count
+}
+
+func Demo() {
+	g := adjacencylist.New(datastructures.Options{
+		TotalVertices: 6,
+		Undirected:    true,
+	})
+	// a path 0 - 1 - 2 - 3 - 4 - 5
+	for v := 0; v < 5; v++ {
+		g.AddEdge(v, v+1, 1)
+	}
+	fmt.Println("independent set:", MaxIndependentSet(g))
+	fmt.Println("vertex cover:   ", MinVertexCover(g))
+	fmt.Println(ForestMaxIndependentSet(g))
+	fmt.Println(BipartiteMinVertexCover(g))
+}
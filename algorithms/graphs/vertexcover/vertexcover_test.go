@@ -0,0 +1,161 @@
+package vertexcover
+
+import (
+	"math/rand"
+	"testing"
+
+	datastructures "github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func isIndependent(g datastructures.Graph, vs []int) bool {
+	for _, u := range vs {
+		for _, v := range vs {
+			if g.Adjacent(u, v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isCover(g datastructures.Graph, vs []int) bool {
+	in := make([]bool, g.Size())
+	for _, v := range vs {
+		in[v] = true
+	}
+	for u := 0; u < g.Size(); u++ {
+		for _, v := range g.Neighbors(u) {
+			if !in[u] && !in[v] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// bruteForce returns the size of a largest independent set.
+func bruteForce(g datastructures.Graph) int {
+	n, best := g.Size(), 0
+	for mask := 0; mask < 1<<n; mask++ {
+		vs := []int{}
+		for v := 0; v < n; v++ {
+			if mask>>v&1 == 1 {
+				vs = append(vs, v)
+			}
+		}
+		if len(vs) > best && isIndependent(g, vs) {
+			best = len(vs)
+		}
+	}
+	return best
+}
+
+func random(rng *rand.Rand, n, m int) datastructures.Graph {
+	g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n), Undirected: true})
+	for i := 0; i < m; i++ {
+		g.AddEdge(rng.Intn(n), rng.Intn(n), 1)
+	}
+	return g
+}
+
+func TestExact(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		n     int
+		edges [][2]int
+		mis   string
+		cover string
+	}{
+		{"path", 4, [][2]int{{0, 1}, {1, 2}, {2, 3}}, "[0 2]", "[1 3]"},
+		{"triangle", 3, [][2]int{{0, 1}, {1, 2}, {0, 2}}, "[0]", "[1 2]"},
+		{"self-loop", 2, [][2]int{{0, 0}}, "[1]", "[0]"},
+		{"empty", 2, [][2]int{}, "[0 1]", "[]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := graphtest.Build(tc.n, true, graphtest.Unweighted(tc.edges))
+			helpers.AssertEqual(t, helpers.ToString(MaxIndependentSet(g)), tc.mis)
+			helpers.AssertEqual(t, helpers.ToString(MinVertexCover(g)), tc.cover)
+		})
+	}
+
+	t.Run("random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for trial := 0; trial < 50; trial++ {
+			n := 1 + rng.Intn(12)
+			g := random(rng, n, rng.Intn(2*n))
+			mis := MaxIndependentSet(g)
+			helpers.Assert(t, isIndependent(g, mis))
+			helpers.AssertEqual(t, len(mis), bruteForce(g))
+			helpers.Assert(t, isCover(g, MinVertexCover(g)))
+		}
+	})
+}
+
+func TestForest(t *testing.T) {
+	t.Run("rejects cycles", func(t *testing.T) {
+		_, err := ForestMaxIndependentSet(graphtest.Build(3, true, graphtest.Unweighted([][2]int{{0, 1}, {1, 2}, {0, 2}})))
+		helpers.Assert(t, err != nil)
+	})
+
+	t.Run("star", func(t *testing.T) {
+		got, err := ForestMinVertexCover(graphtest.Build(5, true, graphtest.Unweighted([][2]int{{0, 1}, {0, 2}, {0, 3}, {0, 4}})))
+		helpers.AssertEqual(t, err, nil)
+		helpers.AssertEqual(t, helpers.ToString(got), "[0]")
+	})
+
+	t.Run("random forests", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(2))
+		for trial := 0; trial < 50; trial++ {
+			n := 1 + rng.Intn(14)
+			g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(n), Undirected: true})
+			for v := 1; v < n; v++ {
+				if rng.Intn(4) > 0 {
+					g.AddEdge(rng.Intn(v), v, 1)
+				}
+			}
+			mis, err := ForestMaxIndependentSet(g)
+			helpers.AssertEqual(t, err, nil)
+			helpers.Assert(t, isIndependent(g, mis))
+			helpers.AssertEqual(t, len(mis), bruteForce(g))
+
+			cover, _ := ForestMinVertexCover(g)
+			helpers.Assert(t, isCover(g, cover))
+		}
+	})
+}
+
+func TestBipartite(t *testing.T) {
+	t.Run("rejects odd cycles", func(t *testing.T) {
+		_, err := BipartiteMinVertexCover(graphtest.Build(3, true, graphtest.Unweighted([][2]int{{0, 1}, {1, 2}, {0, 2}})))
+		helpers.Assert(t, err != nil)
+	})
+
+	t.Run("even cycle", func(t *testing.T) {
+		got, err := BipartiteMinVertexCover(graphtest.Build(6, true, graphtest.Unweighted([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 0}})))
+		helpers.AssertEqual(t, err, nil)
+		helpers.AssertEqual(t, len(got), 3)
+	})
+
+	t.Run("random bipartite graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(3))
+		for trial := 0; trial < 50; trial++ {
+			l, r := 1+rng.Intn(7), 1+rng.Intn(7)
+			g := adjacencylist.New(datastructures.Options{TotalVertices: uint32(l + r), Undirected: true})
+			for i := 0; i < rng.Intn(3*(l+r)); i++ {
+				g.AddEdge(rng.Intn(l), l+rng.Intn(r), 1)
+			}
+			cover, err := BipartiteMinVertexCover(g)
+			helpers.AssertEqual(t, err, nil)
+			helpers.Assert(t, isCover(g, cover))
+			helpers.AssertEqual(t, len(cover), g.Size()-bruteForce(g))
+
+			mis, _ := BipartiteMaxIndependentSet(g)
+			helpers.Assert(t, isIndependent(g, mis))
+		}
+	})
+}
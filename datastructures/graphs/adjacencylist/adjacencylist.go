@@ -10,43 +10,48 @@ import (
 const adjacencyList = "AdjacencyList"
 
 // assumes a maximum of one edge between vertices in an undirected graph
-type Graph struct {
-	totalEdges uint32                          // size of a graph
-	list       []*singlylinkedlist.List[*edge] // adjacency lists
+type Graph[W graphs.Number] struct {
+	totalEdges uint32                             // size of a graph
+	list       []*singlylinkedlist.List[*edge[W]] // adjacency lists
 	undirected bool
 }
 
-type edge struct {
-	weight int
+type edge[W graphs.Number] struct {
+	weight W
 	src    int
 	dst    int
 }
 
 func New(o graphs.Options) graphs.Graph {
-	return &Graph{
+	return NewWeighted[int](o)
+}
+
+// NewWeighted returns a graph whose edge weights have type W.
+func NewWeighted[W graphs.Number](o graphs.Options) graphs.WeightedGraph[W] {
+	return &Graph[W]{
 		0,
-		emptyList(int(o.TotalVertices)),
+		emptyList[W](int(o.TotalVertices)),
 		o.Undirected,
 	}
 }
 
-func (e *edge) String() string {
+func (e *edge[W]) String() string {
 	return fmt.Sprintf("(%v --%v-> %v)", e.src, e.weight, e.dst)
 }
 
-func (g *Graph) Name() string {
+func (g *Graph[W]) Name() string {
 	return adjacencyList
 }
 
-func (g *Graph) Size() int {
+func (g *Graph[W]) Size() int {
 	return len(g.list)
 }
 
-func (g *Graph) Empty() bool {
+func (g *Graph[W]) Empty() bool {
 	return g.totalEdges == 0
 }
 
-func (g *Graph) Values() []int {
+func (g *Graph[W]) Values() []int {
 	vs := []int{}
 	for i := 0; i < g.Size(); i++ {
 		vs = append(vs, i)
@@ -54,22 +59,22 @@ func (g *Graph) Values() []int {
 	return vs
 }
 
-func (g *Graph) String() string {
+func (g *Graph[W]) String() string {
 	return fmt.Sprintf("total edges: %v, total vertices: %v, undirected: %v\n%v", g.totalEdges, len(g.list), g.undirected, g.list)
 	// return fmt.Sprintf("%v", g.list)
 }
 
-func (g *Graph) Reset() {
+func (g *Graph[W]) Reset() {
 	g.totalEdges = 0
-	g.list = emptyList(len(g.list))
+	g.list = emptyList[W](len(g.list))
 }
 
-func (g *Graph) Adjacent(v1, v2 int) bool {
+func (g *Graph[W]) Adjacent(v1, v2 int) bool {
 	_, ok := g.hasEdges(v1, v2)
 	return ok
 }
 
-func (g *Graph) Neighbors(v int) []int {
+func (g *Graph[W]) Neighbors(v int) []int {
 	vs := []int{}
 	for _, e := range g.list[v].Values() {
 		vs = append(vs, e.dst)
@@ -78,12 +83,12 @@ func (g *Graph) Neighbors(v int) []int {
 }
 
 // Reverses the direction of all edges in the same graph
-func (g *Graph) Transpose() graphs.Graph {
+func (g *Graph[W]) Transpose() graphs.WeightedGraph[W] {
 	if !g.undirected {
-		list := emptyList(len(g.list))
+		list := emptyList[W](len(g.list))
 		for _, v := range g.list {
 			for _, e := range v.Values() {
-				edge := &edge{
+				edge := &edge[W]{
 					weight: e.weight,
 					src:    e.dst,
 					dst:    e.src,
@@ -91,7 +96,7 @@ func (g *Graph) Transpose() graphs.Graph {
 				list[e.dst].Add(edge)
 			}
 		}
-		return &Graph{
+		return &Graph[W]{
 			totalEdges: g.totalEdges,
 			list:       list,
 			undirected: g.undirected,
@@ -100,39 +105,59 @@ func (g *Graph) Transpose() graphs.Graph {
 	return g
 }
 
-func (g *Graph) AddVertex() {
-	g.list = append(g.list, singlylinkedlist.New[*edge]())
+func (g *Graph[W]) Weight(src, dst int) (W, bool) {
+	if idxs, ok := g.hasEdges(src, dst); ok {
+		e, _ := g.list[src].Get(idxs[0])
+		return e.weight, true
+	}
+	return 0, false
+}
+
+func (g *Graph[W]) Undirected() bool {
+	return g.undirected
 }
 
-func (g *Graph) RemoveVertex(v int) bool {
+func (g *Graph[W]) AddVertex() {
+	g.list = append(g.list, singlylinkedlist.New[*edge[W]]())
+}
+
+// RemoveVertex removes v and its edges, and moves every vertex with a higher
+// id down by one.
+func (g *Graph[W]) RemoveVertex(v int) bool {
 	if !g.withinRange(v) {
 		return false
 	}
-	for _, list := range g.list {
-		edges := []int{}
-		for i, edge := range list.Values() {
-			if edge.src == v || edge.dst == v {
-				edges = append(edges, i)
+	// every edge out of v, including a self-loop, is in its own list; an
+	// undirected edge into v is the same edge as one out of it
+	removed := uint32(g.list[v].Size())
+	g.list = append(g.list[:v], g.list[v+1:]...)
+	for u, list := range g.list {
+		kept := singlylinkedlist.New[*edge[W]]()
+		for _, e := range list.Values() {
+			if e.dst == v {
+				if !g.undirected {
+					removed++
+				}
+				continue
 			}
-			if edge.dst > v {
-				edge.dst -= 1
+			e.src = u
+			if e.dst > v {
+				e.dst--
 			}
+			kept.Add(e)
 		}
-		for i := range edges {
-			list.Remove(i)
-		}
+		g.list[u] = kept
 	}
-
-	g.list = append(g.list[:v], g.list[v+1:]...)
+	g.totalEdges -= removed
 
 	return true
 }
 
-func (g *Graph) AddEdge(src, dst, weight int) bool {
+func (g *Graph[W]) AddEdge(src, dst int, weight W) bool {
 	return g.UpdateEdge(src, dst, weight)
 }
 
-func (g *Graph) UpdateEdge(src, dst, weight int) bool {
+func (g *Graph[W]) UpdateEdge(src, dst int, weight W) bool {
 	var directed, undirected bool
 
 	if idxs, ok := g.hasEdges(src, dst); ok {
@@ -144,10 +169,10 @@ func (g *Graph) UpdateEdge(src, dst, weight int) bool {
 		}
 	} else {
 		g.totalEdges++
-		e := &edge{weight, src, dst}
+		e := &edge[W]{weight, src, dst}
 		directed = g.list[src].Add(e)
 		if g.undirected && src != dst {
-			e := &edge{weight, dst, src}
+			e := &edge[W]{weight, dst, src}
 			undirected = g.list[dst].Add(e)
 		}
 		if src == dst {
@@ -157,11 +182,11 @@ func (g *Graph) UpdateEdge(src, dst, weight int) bool {
 	return g.undirected && directed && undirected || directed
 }
 
-func (g *Graph) RemoveEdge(src, dst int) bool {
+func (g *Graph[W]) RemoveEdge(src, dst int) bool {
 
 	if idxs, ok := g.hasEdges(src, dst); ok {
 		g.list[src].Remove(idxs[0])
-		if g.undirected {
+		if g.undirected && src != dst {
 			g.list[dst].Remove(idxs[1])
 		}
 		g.totalEdges--
@@ -171,7 +196,7 @@ func (g *Graph) RemoveEdge(src, dst int) bool {
 	return false
 }
 
-func (g *Graph) hasEdges(src, dst int) ([]int, bool) {
+func (g *Graph[W]) hasEdges(src, dst int) ([]int, bool) {
 	totalVertices := len(g.list)
 	idxs := make([]int, 2)
 	var directed, undirected bool // boolean checks for whether there are directed or undirected edges between src and dst
@@ -205,14 +230,14 @@ func (g *Graph) hasEdges(src, dst int) ([]int, bool) {
 	return idxs, g.undirected && directed && undirected || directed
 }
 
-func (g *Graph) withinRange(v int) bool {
-	return v < len(g.list)
+func (g *Graph[W]) withinRange(v int) bool {
+	return v >= 0 && v < len(g.list)
 }
 
-func emptyList(order int) []*singlylinkedlist.List[*edge] {
-	list := make([]*singlylinkedlist.List[*edge], order)
+func emptyList[W graphs.Number](order int) []*singlylinkedlist.List[*edge[W]] {
+	list := make([]*singlylinkedlist.List[*edge[W]], order)
 	for i := 0; i < order; i++ {
-		list[i] = singlylinkedlist.New[*edge]()
+		list[i] = singlylinkedlist.New[*edge[W]]()
 	}
 	return list
 }
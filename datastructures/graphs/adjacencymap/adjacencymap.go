@@ -0,0 +1,239 @@
+package adjacencymap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+const adjacencyMap = "AdjacencyMap"
+
+// Graph keeps the edges out of every vertex in a slice indexed by a hash map
+// from destination to position, so Adjacent, Weight, UpdateEdge and RemoveEdge
+// take O(1) expected time whatever the degree. Neighbors come in insertion
+// order, except that removing an edge moves the last one out of its vertex
+// into its place. Like adjacencylist it holds at most one edge from one vertex
+// to another.
+type Graph[W graphs.Number] struct {
+	totalEdges uint32
+	vertices   []*vertex[W]
+	undirected bool
+}
+
+type vertex[W graphs.Number] struct {
+	edges []edge[W]
+	index map[int]int // position in edges of the edge to each neighbour
+}
+
+type edge[W graphs.Number] struct {
+	dst    int
+	weight W
+}
+
+func New(o graphs.Options) graphs.Graph {
+	return NewWeighted[int](o)
+}
+
+// NewWeighted returns a graph whose edge weights have type W.
+func NewWeighted[W graphs.Number](o graphs.Options) graphs.WeightedGraph[W] {
+	return &Graph[W]{
+		0,
+		emptyVertices[W](int(o.TotalVertices)),
+		o.Undirected,
+	}
+}
+
+func (e edge[W]) String() string {
+	return fmt.Sprintf("(--%v-> %v)", e.weight, e.dst)
+}
+
+func (g *Graph[W]) Name() string {
+	return adjacencyMap
+}
+
+func (g *Graph[W]) Size() int {
+	return len(g.vertices)
+}
+
+func (g *Graph[W]) Empty() bool {
+	return g.totalEdges == 0
+}
+
+func (g *Graph[W]) Values() []int {
+	vs := []int{}
+	for i := 0; i < g.Size(); i++ {
+		vs = append(vs, i)
+	}
+	return vs
+}
+
+func (g *Graph[W]) String() string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "total edges: %v, total vertices: %v, undirected: %v\n", g.totalEdges, len(g.vertices), g.undirected)
+	for v, x := range g.vertices {
+		fmt.Fprintf(&b, "%v: %v\n", v, x.edges)
+	}
+	return b.String()
+}
+
+func (g *Graph[W]) Reset() {
+	g.totalEdges = 0
+	g.vertices = emptyVertices[W](len(g.vertices))
+}
+
+func (g *Graph[W]) Adjacent(v1, v2 int) bool {
+	_, ok := g.find(v1, v2)
+	return ok
+}
+
+func (g *Graph[W]) Neighbors(v int) []int {
+	vs := make([]int, len(g.vertices[v].edges))
+	for i, e := range g.vertices[v].edges {
+		vs[i] = e.dst
+	}
+	return vs
+}
+
+// Degree returns the number of edges out of v in O(1) time.
+func (g *Graph[W]) Degree(v int) int {
+	return len(g.vertices[v].edges)
+}
+
+// Reverses the direction of all edges in a new graph
+func (g *Graph[W]) Transpose() graphs.WeightedGraph[W] {
+	if g.undirected {
+		return g
+	}
+	t := &Graph[W]{
+		totalEdges: g.totalEdges,
+		vertices:   emptyVertices[W](len(g.vertices)),
+		undirected: g.undirected,
+	}
+	for src, x := range g.vertices {
+		for _, e := range x.edges {
+			t.vertices[e.dst].add(src, e.weight)
+		}
+	}
+	return t
+}
+
+func (g *Graph[W]) Weight(src, dst int) (W, bool) {
+	if i, ok := g.find(src, dst); ok {
+		return g.vertices[src].edges[i].weight, true
+	}
+	return 0, false
+}
+
+func (g *Graph[W]) Undirected() bool {
+	return g.undirected
+}
+
+func (g *Graph[W]) AddVertex() {
+	g.vertices = append(g.vertices, newVertex[W]())
+}
+
+// RemoveVertex removes v and its edges, and moves every vertex with a higher
+// id down by one.
+func (g *Graph[W]) RemoveVertex(v int) bool {
+	if !g.withinRange(v) {
+		return false
+	}
+	removed := uint32(len(g.vertices[v].edges))
+	g.vertices = append(g.vertices[:v], g.vertices[v+1:]...)
+	for _, x := range g.vertices {
+		if x.remove(v) && !g.undirected {
+			removed++
+		}
+		for i := range x.edges {
+			if e := &x.edges[i]; e.dst > v {
+				delete(x.index, e.dst)
+				e.dst--
+			}
+		}
+		for i, e := range x.edges {
+			x.index[e.dst] = i
+		}
+	}
+	g.totalEdges -= removed
+	return true
+}
+
+func (g *Graph[W]) AddEdge(src, dst int, weight W) bool {
+	return g.UpdateEdge(src, dst, weight)
+}
+
+func (g *Graph[W]) UpdateEdge(src, dst int, weight W) bool {
+	if i, ok := g.find(src, dst); ok {
+		g.vertices[src].edges[i].weight = weight
+		if g.undirected && src != dst {
+			g.vertices[dst].edges[g.vertices[dst].index[src]].weight = weight
+		}
+		return false
+	}
+	g.totalEdges++
+	g.vertices[src].add(dst, weight)
+	if g.undirected && src != dst {
+		g.vertices[dst].add(src, weight)
+	}
+	return true
+}
+
+func (g *Graph[W]) RemoveEdge(src, dst int) bool {
+	if _, ok := g.find(src, dst); !ok {
+		return false
+	}
+	g.vertices[src].remove(dst)
+	if g.undirected && src != dst {
+		g.vertices[dst].remove(src)
+	}
+	g.totalEdges--
+	return true
+}
+
+// find returns the position of the edge from src to dst among the edges out
+// of src.
+func (g *Graph[W]) find(src, dst int) (int, bool) {
+	if !g.withinRange(src) || !g.withinRange(dst) {
+		return 0, false
+	}
+	i, ok := g.vertices[src].index[dst]
+	return i, ok
+}
+
+func (g *Graph[W]) withinRange(v int) bool {
+	return v >= 0 && v < len(g.vertices)
+}
+
+func (x *vertex[W]) add(dst int, weight W) {
+	x.index[dst] = len(x.edges)
+	x.edges = append(x.edges, edge[W]{dst, weight})
+}
+
+// remove deletes the edge to dst by moving the last edge into its place.
+func (x *vertex[W]) remove(dst int) bool {
+	i, ok := x.index[dst]
+	if !ok {
+		return false
+	}
+	last := len(x.edges) - 1
+	if i != last {
+		x.edges[i] = x.edges[last]
+		x.index[x.edges[i].dst] = i
+	}
+	x.edges = x.edges[:last]
+	delete(x.index, dst)
+	return true
+}
+
+func newVertex[W graphs.Number]() *vertex[W] {
+	return &vertex[W]{[]edge[W]{}, map[int]int{}}
+}
+
+func emptyVertices[W graphs.Number](order int) []*vertex[W] {
+	vertices := make([]*vertex[W], order)
+	for i := range vertices {
+		vertices[i] = newVertex[W]()
+	}
+	return vertices
+}
@@ -0,0 +1,104 @@
+package adjacencymap_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymap"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestTranspose(t *testing.T) {
+	g := adjacencymap.New(graphs.Options{TotalVertices: 3})
+	g.AddEdge(0, 1, 4)
+	g.AddEdge(0, 2, 5)
+	g.AddEdge(2, 1, 6)
+
+	tr := g.Transpose()
+	helpers.AssertEqual(t, helpers.ToString(tr.Neighbors(1)), "[0 2]")
+	w, ok := tr.Weight(2, 0)
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, w, 5)
+	helpers.Assert(t, !tr.Adjacent(0, 1))
+
+	helpers.Assert(t, g.RemoveEdge(0, 1))
+	helpers.AssertEqual(t, helpers.ToString(g.Neighbors(0)), "[2]")
+	helpers.AssertEqual(t, g.(*adjacencymap.Graph[int]).Degree(0), 1)
+}
+
+var constructors = []struct {
+	name string
+	new  func(graphs.Options) graphs.Graph
+}{
+	{"AdjacencyList", adjacencylist.New},
+	{"AdjacencyMap", adjacencymap.New},
+}
+
+// hub returns a graph in which vertex 0 has an edge to every other vertex.
+func hub(newGraph func(graphs.Options) graphs.Graph, n int) graphs.Graph {
+	g := newGraph(graphs.Options{TotalVertices: uint32(n)})
+	for v := 1; v < n; v++ {
+		g.AddEdge(0, v, v)
+	}
+	return g
+}
+
+func BenchmarkAdjacent(b *testing.B) {
+	for _, c := range constructors {
+		for _, n := range []int{10, 100, 1000} {
+			b.Run(fmt.Sprintf("%v/degree=%v", c.name, n), func(b *testing.B) {
+				g := hub(c.new, n)
+				rng := rand.New(rand.NewSource(1))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					g.Adjacent(0, rng.Intn(n))
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkUpdateEdge(b *testing.B) {
+	for _, c := range constructors {
+		b.Run(c.name, func(b *testing.B) {
+			n := 1000
+			g := hub(c.new, n)
+			rng := rand.New(rand.NewSource(1))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				g.UpdateEdge(0, 1+rng.Intn(n-1), i)
+			}
+		})
+	}
+}
+
+func BenchmarkRemoveEdge(b *testing.B) {
+	for _, c := range constructors {
+		b.Run(c.name, func(b *testing.B) {
+			n := 1000
+			g := hub(c.new, n)
+			rng := rand.New(rand.NewSource(1))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				v := 1 + rng.Intn(n-1)
+				g.RemoveEdge(0, v)
+				g.AddEdge(0, v, v)
+			}
+		})
+	}
+}
+
+func BenchmarkNeighbors(b *testing.B) {
+	for _, c := range constructors {
+		b.Run(c.name, func(b *testing.B) {
+			g := hub(c.new, 1000)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				g.Neighbors(0)
+			}
+		})
+	}
+}
@@ -11,35 +11,40 @@ const (
 	zeroWeight      = 0
 )
 
-type Graph struct {
+type Graph[W graphs.Number] struct {
 	totalVertices uint32 // order of a graph
 	totalEdges    uint32 // size of a graph
-	matrix        [][]int
+	matrix        [][]W
 	undirected    bool
 }
 
 func New(o graphs.Options) graphs.Graph {
-	return &Graph{
+	return NewWeighted[int](o)
+}
+
+// NewWeighted returns a graph whose edge weights have type W.
+func NewWeighted[W graphs.Number](o graphs.Options) graphs.WeightedGraph[W] {
+	return &Graph[W]{
 		o.TotalVertices,
 		0,
-		emptyMatrix(o.TotalVertices),
+		emptyMatrix[W](o.TotalVertices),
 		o.Undirected,
 	}
 }
 
-func (g *Graph) Name() string {
+func (g *Graph[W]) Name() string {
 	return adjacencyMatrix
 }
 
-func (g *Graph) Size() int {
+func (g *Graph[W]) Size() int {
 	return int(g.totalVertices)
 }
 
-func (g *Graph) Empty() bool {
+func (g *Graph[W]) Empty() bool {
 	return g.totalEdges == 0
 }
 
-func (g *Graph) Values() []int {
+func (g *Graph[W]) Values() []int {
 	vs := []int{}
 	for i := 0; i < g.Size(); i++ {
 		vs = append(vs, i)
@@ -47,7 +52,7 @@ func (g *Graph) Values() []int {
 	return vs
 }
 
-func (g *Graph) String() string {
+func (g *Graph[W]) String() string {
 	str := ""
 
 	for i := range g.matrix {
@@ -57,16 +62,16 @@ func (g *Graph) String() string {
 	return str
 }
 
-func (g *Graph) Reset() {
+func (g *Graph[W]) Reset() {
 	g.totalEdges = 0
-	g.matrix = emptyMatrix(g.totalVertices)
+	g.matrix = emptyMatrix[W](g.totalVertices)
 }
 
-func (g *Graph) Adjacent(v1, v2 int) bool {
+func (g *Graph[W]) Adjacent(v1, v2 int) bool {
 	return g.hasEdge(v1, v2)
 }
 
-func (g *Graph) Neighbors(v int) []int {
+func (g *Graph[W]) Neighbors(v int) []int {
 	vs := []int{}
 	for i := 0; i < int(g.totalVertices); i++ {
 		if g.matrix[v][i] != 0 {
@@ -76,15 +81,15 @@ func (g *Graph) Neighbors(v int) []int {
 	return vs
 }
 
-func (g *Graph) Transpose() graphs.Graph {
+func (g *Graph[W]) Transpose() graphs.WeightedGraph[W] {
 	if !g.undirected {
-		matrix := emptyMatrix(g.totalVertices)
+		matrix := emptyMatrix[W](g.totalVertices)
 		for i := 0; i < int(g.totalVertices); i++ {
 			for j := 0; j < int(g.totalVertices); j++ {
 				matrix[j][i] = g.matrix[i][j]
 			}
 		}
-		return &Graph{
+		return &Graph[W]{
 			totalVertices: g.totalVertices,
 			totalEdges:    g.totalEdges,
 			matrix:        matrix,
@@ -94,24 +99,42 @@ func (g *Graph) Transpose() graphs.Graph {
 	return g
 }
 
-func (g *Graph) AddVertex() {
+func (g *Graph[W]) Weight(src, dst int) (W, bool) {
+	if !g.withinRange(src) || !g.withinRange(dst) || !g.hasEdge(src, dst) {
+		return 0, false
+	}
+	return g.matrix[src][dst], true
+}
+
+func (g *Graph[W]) Undirected() bool {
+	return g.undirected
+}
+
+func (g *Graph[W]) AddVertex() {
 	g.totalVertices++
-	matrix := emptyMatrix(g.totalVertices)
+	matrix := emptyMatrix[W](g.totalVertices)
 	for i := uint32(0); i < g.totalVertices-1; i++ {
-		for j := uint32(0); i < g.totalVertices-1; i++ {
+		for j := uint32(0); j < g.totalVertices-1; j++ {
 			matrix[i][j] = g.matrix[i][j]
 		}
 	}
 	g.matrix = matrix
 }
 
-func (g *Graph) RemoveVertex(v int) bool {
+func (g *Graph[W]) RemoveVertex(v int) bool {
 	if !g.withinRange(v) {
 		return false
 	}
 	edgesConnected := len(g.Neighbors(v))
+	if !g.undirected {
+		for u := 0; u < g.Size(); u++ {
+			if u != v && g.matrix[u][v] != zeroWeight {
+				edgesConnected++
+			}
+		}
+	}
 	g.totalEdges -= uint32(edgesConnected)
-	matrix := emptyMatrix(g.totalVertices - 1)
+	matrix := emptyMatrix[W](g.totalVertices - 1)
 	for i := uint32(0); i < g.totalVertices; i++ {
 		for j := uint32(0); j < g.totalVertices; j++ {
 			row, col := i, j
@@ -132,11 +155,11 @@ func (g *Graph) RemoveVertex(v int) bool {
 	return true
 }
 
-func (g *Graph) AddEdge(src, dst, weight int) bool {
+func (g *Graph[W]) AddEdge(src, dst int, weight W) bool {
 	return g.UpdateEdge(src, dst, weight)
 }
 
-func (g *Graph) UpdateEdge(src, dst, weight int) (inserted bool) {
+func (g *Graph[W]) UpdateEdge(src, dst int, weight W) (inserted bool) {
 	if !g.hasEdge(src, dst) {
 		inserted = true
 		g.totalEdges++
@@ -148,9 +171,10 @@ func (g *Graph) UpdateEdge(src, dst, weight int) (inserted bool) {
 	return
 }
 
-func (g *Graph) RemoveEdge(src, dst int) (ok bool) {
+func (g *Graph[W]) RemoveEdge(src, dst int) (ok bool) {
 	if g.hasEdge(src, dst) {
 		ok = true
+		g.totalEdges--
 	}
 	g.matrix[src][dst] = zeroWeight
 	if g.undirected {
@@ -159,17 +183,17 @@ func (g *Graph) RemoveEdge(src, dst int) (ok bool) {
 	return
 }
 
-func (g *Graph) hasEdge(src, dst int) bool {
+func (g *Graph[W]) hasEdge(src, dst int) bool {
 	return g.undirected && g.matrix[dst][src] != zeroWeight && g.matrix[src][dst] != zeroWeight || g.matrix[src][dst] != zeroWeight
 }
 
-func (g *Graph) withinRange(v int) bool {
+func (g *Graph[W]) withinRange(v int) bool {
 	return uint32(v) < g.totalVertices
 }
 
-func emptyMatrix(order uint32) [][]int {
-	matrix := make([][]int, order)
-	rows := make([]int, order*order)
+func emptyMatrix[W graphs.Number](order uint32) [][]W {
+	matrix := make([][]W, order)
+	rows := make([]W, order*order)
 	for i := range matrix {
 		matrix[i], rows = rows[:order], rows[order:]
 	}
@@ -0,0 +1,319 @@
+package bitmatrix
+
+import (
+	"math/bits"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+const (
+	bitMatrix = "BitMatrix"
+	wordSize  = 64
+)
+
+// Graph is an unweighted adjacency matrix that packs every row into 64-bit
+// words, one bit per cell, so it takes n²/8 bytes instead of the 8n² of an
+// adjacencymatrix. Sets of neighbours are whole rows, which lets
+// CommonNeighbors, Intersection and Triangles work on 64 vertices at a time.
+//
+// Edges have no weights: AddEdge and UpdateEdge ignore the weight they are
+// given and Weight reports 1 for every edge.
+type Graph struct {
+	totalVertices int
+	totalEdges    int
+	stride        int      // words per row
+	rows          []uint64 // row v is rows[v*stride : (v+1)*stride]
+	undirected    bool
+}
+
+func New(o graphs.Options) graphs.Graph {
+	n := int(o.TotalVertices)
+	return &Graph{
+		totalVertices: n,
+		stride:        words(n),
+		rows:          make([]uint64, n*words(n)),
+		undirected:    o.Undirected,
+	}
+}
+
+func (g *Graph) Name() string {
+	return bitMatrix
+}
+
+func (g *Graph) Size() int {
+	return g.totalVertices
+}
+
+func (g *Graph) Empty() bool {
+	return g.totalEdges == 0
+}
+
+func (g *Graph) Values() []int {
+	vs := []int{}
+	for i := 0; i < g.Size(); i++ {
+		vs = append(vs, i)
+	}
+	return vs
+}
+
+func (g *Graph) String() string {
+	b := strings.Builder{}
+	for u := 0; u < g.totalVertices; u++ {
+		for v := 0; v < g.totalVertices; v++ {
+			if g.has(u, v) {
+				b.WriteByte('1')
+			} else {
+				b.WriteByte('0')
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (g *Graph) Reset() {
+	g.totalEdges = 0
+	clear(g.rows)
+}
+
+func (g *Graph) Adjacent(v1, v2 int) bool {
+	return g.withinRange(v1) && g.withinRange(v2) && g.has(v1, v2)
+}
+
+func (g *Graph) Neighbors(v int) []int {
+	return members(g.row(v))
+}
+
+// Degree returns the number of edges out of v.
+func (g *Graph) Degree(v int) int {
+	return count(g.row(v))
+}
+
+func (g *Graph) Transpose() graphs.Graph {
+	if g.undirected {
+		return g
+	}
+	t := &Graph{
+		totalVertices: g.totalVertices,
+		totalEdges:    g.totalEdges,
+		stride:        g.stride,
+		rows:          make([]uint64, len(g.rows)),
+		undirected:    g.undirected,
+	}
+	for u := 0; u < g.totalVertices; u++ {
+		for _, v := range g.Neighbors(u) {
+			t.set(v, u)
+		}
+	}
+	return t
+}
+
+// Weight reports 1 if there is an edge from src to dst.
+func (g *Graph) Weight(src, dst int) (int, bool) {
+	if !g.Adjacent(src, dst) {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (g *Graph) Undirected() bool {
+	return g.undirected
+}
+
+func (g *Graph) AddVertex() {
+	n := g.totalVertices + 1
+	if words(n) > g.stride {
+		// double the row width so that adding vertices one at a time costs
+		// amortised O(n) words each
+		stride := max(words(n), 2*g.stride)
+		rows := make([]uint64, n*stride)
+		for v := 0; v < g.totalVertices; v++ {
+			copy(rows[v*stride:], g.row(v))
+		}
+		g.stride, g.rows = stride, rows
+	} else {
+		g.rows = append(g.rows, make([]uint64, g.stride)...)
+	}
+	g.totalVertices = n
+}
+
+// RemoveVertex removes v and its edges, and moves every vertex with a higher
+// id down by one.
+func (g *Graph) RemoveVertex(v int) bool {
+	if !g.withinRange(v) {
+		return false
+	}
+	removed := g.Degree(v)
+	if !g.undirected {
+		for u := 0; u < g.totalVertices; u++ {
+			if u != v && g.has(u, v) {
+				removed++
+			}
+		}
+	}
+	g.totalEdges -= removed
+
+	copy(g.rows[v*g.stride:], g.rows[(v+1)*g.stride:])
+	g.totalVertices--
+	g.rows = g.rows[:g.totalVertices*g.stride]
+	for u := 0; u < g.totalVertices; u++ {
+		removeBit(g.row(u), v)
+	}
+	return true
+}
+
+// AddEdge adds an edge from src to dst. The weight is ignored.
+func (g *Graph) AddEdge(src, dst int, weight int) bool {
+	return g.UpdateEdge(src, dst, weight)
+}
+
+// UpdateEdge adds an edge from src to dst if it is missing. The weight is
+// ignored.
+func (g *Graph) UpdateEdge(src, dst int, weight int) bool {
+	if !g.withinRange(src) || !g.withinRange(dst) || g.has(src, dst) {
+		return false
+	}
+	g.totalEdges++
+	g.set(src, dst)
+	if g.undirected {
+		g.set(dst, src)
+	}
+	return true
+}
+
+func (g *Graph) RemoveEdge(src, dst int) bool {
+	if !g.Adjacent(src, dst) {
+		return false
+	}
+	g.totalEdges--
+	g.unset(src, dst)
+	if g.undirected {
+		g.unset(dst, src)
+	}
+	return true
+}
+
+// CommonNeighbors returns the number of vertices that both u and v have an
+// edge to.
+func (g *Graph) CommonNeighbors(u, v int) int {
+	c := 0
+	for i, w := range g.row(u) {
+		c += bits.OnesCount64(w & g.row(v)[i])
+	}
+	return c
+}
+
+// Intersection returns the vertices that both u and v have an edge to, in
+// increasing order.
+func (g *Graph) Intersection(u, v int) []int {
+	and := make([]uint64, g.stride)
+	for i, w := range g.row(u) {
+		and[i] = w & g.row(v)[i]
+	}
+	return members(and)
+}
+
+// Triangles returns the number of sets of three vertices that are pairwise
+// adjacent. Directed edges count in either direction and self-loops are
+// ignored. For each edge {u, v} with u < v it counts the common neighbours
+// above v a word at a time, in O(V E / 64) time.
+func (g *Graph) Triangles() int {
+	s := g.symmetric()
+	triangles := 0
+	for u := 0; u < s.totalVertices; u++ {
+		ru := s.row(u)
+		for _, v := range members(ru) {
+			if v <= u {
+				continue
+			}
+			rv := s.row(v)
+			// only count w > v so each triangle is counted once
+			first := (v + 1) / wordSize
+			if first >= s.stride {
+				continue
+			}
+			triangles += bits.OnesCount64(ru[first] & rv[first] &^ (1<<((v+1)%wordSize) - 1))
+			for i := first + 1; i < s.stride; i++ {
+				triangles += bits.OnesCount64(ru[i] & rv[i])
+			}
+		}
+	}
+	return triangles
+}
+
+// symmetric returns g with every edge in both directions and no self-loops.
+func (g *Graph) symmetric() *Graph {
+	s := &Graph{
+		totalVertices: g.totalVertices,
+		stride:        g.stride,
+		rows:          append([]uint64{}, g.rows...),
+		undirected:    true,
+	}
+	for u := 0; u < g.totalVertices; u++ {
+		if !g.undirected {
+			for _, v := range g.Neighbors(u) {
+				s.set(v, u)
+			}
+		}
+		s.unset(u, u)
+	}
+	return s
+}
+
+func (g *Graph) row(v int) []uint64 {
+	return g.rows[v*g.stride : (v+1)*g.stride]
+}
+
+func (g *Graph) has(src, dst int) bool {
+	return g.rows[src*g.stride+dst/wordSize]&(1<<(dst%wordSize)) != 0
+}
+
+func (g *Graph) set(src, dst int) {
+	g.rows[src*g.stride+dst/wordSize] |= 1 << (dst % wordSize)
+}
+
+func (g *Graph) unset(src, dst int) {
+	g.rows[src*g.stride+dst/wordSize] &^= 1 << (dst % wordSize)
+}
+
+func (g *Graph) withinRange(v int) bool {
+	return v >= 0 && v < g.totalVertices
+}
+
+// words returns the number of words needed for n bits.
+func words(n int) int {
+	return (n + wordSize - 1) / wordSize
+}
+
+func count(row []uint64) int {
+	c := 0
+	for _, w := range row {
+		c += bits.OnesCount64(w)
+	}
+	return c
+}
+
+// members returns the positions of the set bits in row.
+func members(row []uint64) []int {
+	vs := []int{}
+	for i, w := range row {
+		for w != 0 {
+			vs = append(vs, i*wordSize+bits.TrailingZeros64(w))
+			w &= w - 1
+		}
+	}
+	return vs
+}
+
+// removeBit deletes bit v from row, moving every higher bit down by one.
+func removeBit(row []uint64, v int) {
+	i, b := v/wordSize, uint(v%wordSize)
+	low := row[i] & (1<<b - 1)
+	high := row[i] >> (b + 1) << b
+	row[i] = low | high
+	for ; i+1 < len(row); i++ {
+		row[i] |= row[i+1] << (wordSize - 1)
+		row[i+1] >>= 1
+	}
+}
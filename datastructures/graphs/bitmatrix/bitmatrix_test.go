@@ -0,0 +1,150 @@
+package bitmatrix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/bitmatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// random returns the same random graph as a bit matrix and as an adjacency
+// matrix with unit weights.
+func random(rng *rand.Rand, n int, p float64, undirected bool) (*bitmatrix.Graph, graphs.Graph) {
+	o := graphs.Options{TotalVertices: uint32(n), Undirected: undirected}
+	b, g := bitmatrix.New(o).(*bitmatrix.Graph), adjacencymatrix.New(o)
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if rng.Float64() < p {
+				b.AddEdge(u, v, 1)
+				g.AddEdge(u, v, 1)
+			}
+		}
+	}
+	return b, g
+}
+
+func same(t *testing.T, b graphs.Graph, g graphs.Graph) {
+	t.Helper()
+	helpers.AssertEqual(t, b.Size(), g.Size())
+	helpers.AssertEqual(t, b.Empty(), g.Empty())
+	for u := 0; u < g.Size(); u++ {
+		helpers.AssertEqual(t, helpers.ToString(b.Neighbors(u)), helpers.ToString(g.Neighbors(u)))
+	}
+}
+
+func TestAcrossWords(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, undirected := range []bool{false, true} {
+		t.Run(fmt.Sprintf("undirected=%v", undirected), func(t *testing.T) {
+			b, g := random(rng, 130, 0.05, undirected)
+			same(t, b, g)
+			same(t, b.Transpose(), g.Transpose())
+			for _, v := range []int{129, 64, 63, 0, 70} {
+				helpers.Assert(t, b.RemoveVertex(v))
+				g.RemoveVertex(v)
+				same(t, b, g)
+			}
+			for i := 0; i < 70; i++ {
+				b.AddVertex()
+				g.AddVertex()
+			}
+			b.AddEdge(3, 190, 1)
+			g.AddEdge(3, 190, 1)
+			same(t, b, g)
+			for u := 0; u < g.Size(); u++ {
+				for _, v := range g.Neighbors(u) {
+					b.RemoveEdge(u, v)
+					g.RemoveEdge(u, v)
+				}
+			}
+			helpers.Assert(t, b.Empty())
+		})
+	}
+}
+
+func TestCommonNeighbors(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	b, g := random(rng, 100, 0.2, false)
+	for trial := 0; trial < 50; trial++ {
+		u, v := rng.Intn(100), rng.Intn(100)
+		want := []int{}
+		for _, w := range g.Neighbors(u) {
+			if g.Adjacent(v, w) {
+				want = append(want, w)
+			}
+		}
+		helpers.AssertEqual(t, b.CommonNeighbors(u, v), len(want))
+		helpers.AssertEqual(t, helpers.ToString(b.Intersection(u, v)), helpers.ToString(want))
+	}
+}
+
+func TestTriangles(t *testing.T) {
+	k4 := bitmatrix.New(graphs.Options{TotalVertices: 4, Undirected: true}).(*bitmatrix.Graph)
+	for u := 0; u < 4; u++ {
+		for v := u; v < 4; v++ {
+			k4.AddEdge(u, v, 1)
+		}
+	}
+	helpers.AssertEqual(t, k4.Triangles(), 4)
+
+	rng := rand.New(rand.NewSource(3))
+	for _, undirected := range []bool{false, true} {
+		b, g := random(rng, 90, 0.1, undirected)
+		adjacent := func(u, v int) bool { return g.Adjacent(u, v) || g.Adjacent(v, u) }
+		want := 0
+		for u := 0; u < 90; u++ {
+			for v := u + 1; v < 90; v++ {
+				for w := v + 1; w < 90; w++ {
+					if adjacent(u, v) && adjacent(v, w) && adjacent(u, w) {
+						want++
+					}
+				}
+			}
+		}
+		helpers.AssertEqual(t, b.Triangles(), want)
+	}
+}
+
+func BenchmarkCommonNeighbors(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	bm, g := random(rng, 1000, 0.3, true)
+	b.Run("BitMatrix", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bm.CommonNeighbors(i%1000, (i*7)%1000)
+		}
+	})
+	b.Run("AdjacencyMatrix", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u, v, c := i%1000, (i*7)%1000, 0
+			for _, w := range g.Neighbors(u) {
+				if g.Adjacent(v, w) {
+					c++
+				}
+			}
+		}
+	})
+}
+
+func TestOutOfRange(t *testing.T) {
+	for _, undirected := range []bool{false, true} {
+		g := bitmatrix.New(graphs.Options{TotalVertices: 3, Undirected: undirected})
+		g.AddEdge(0, 1, 1)
+		// 3 and 63 are padding bits in the single word of each row
+		for _, e := range [][2]int{{0, 3}, {3, 0}, {1, 63}, {-1, 0}, {0, -1}, {64, 2}} {
+			helpers.Assert(t, !g.AddEdge(e[0], e[1], 1))
+			helpers.Assert(t, !g.UpdateEdge(e[0], e[1], 1))
+			helpers.Assert(t, !g.RemoveEdge(e[0], e[1]))
+			helpers.Assert(t, !g.Adjacent(e[0], e[1]))
+		}
+		helpers.AssertEqual(t, helpers.ToString(g.Neighbors(0)), "[1]")
+		want := "[]"
+		if undirected {
+			want = "[0]"
+		}
+		helpers.AssertEqual(t, helpers.ToString(g.Neighbors(1)), want)
+	}
+}
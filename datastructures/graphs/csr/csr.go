@@ -0,0 +1,253 @@
+package csr
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+const compressedSparseRow = "CSR"
+
+// Graph is an immutable graph in compressed sparse row form: the neighbours of
+// u are targets[offsets[u]:offsets[u+1]], sorted, with the weight of each edge
+// at the same index of weights. Three flat slices make traversals cache
+// friendly and cost a target and a weight per edge plus an offset per vertex.
+// Adjacent and Weight search the sorted neighbours in O(log deg) time.
+//
+// Graph has every method of graphs.WeightedGraph so that all algorithms run on
+// it, but the ones that would change it do nothing and report false.
+type Graph[W graphs.Number] struct {
+	totalEdges int
+	offsets    []int
+	targets    []int
+	weights    []W
+	undirected bool
+}
+
+// Edge is an edge from Src to Dst.
+type Edge[W graphs.Number] struct {
+	Src, Dst int
+	Weight   W
+}
+
+// From copies g.
+func From[W graphs.Number](g graphs.WeightedGraph[W]) *Graph[W] {
+	n := g.Size()
+	c := &Graph[W]{offsets: make([]int, n+1), undirected: g.Undirected()}
+	for u := 0; u < n; u++ {
+		neighbors := g.Neighbors(u)
+		slices.Sort(neighbors)
+		for _, v := range neighbors {
+			w, _ := g.Weight(u, v)
+			c.targets = append(c.targets, v)
+			c.weights = append(c.weights, w)
+			if !c.undirected || u <= v {
+				c.totalEdges++
+			}
+		}
+		c.offsets[u+1] = len(c.targets)
+	}
+	return c
+}
+
+// FromEdges builds a graph on o.TotalVertices vertices from a list of edges.
+// An undirected edge may be given in either direction. If an edge is given
+// more than once, the last weight wins.
+func FromEdges[W graphs.Number](o graphs.Options, edges ...Edge[W]) (*Graph[W], error) {
+	n := int(o.TotalVertices)
+	c := &Graph[W]{offsets: make([]int, n+1), undirected: o.Undirected}
+	for i, e := range edges {
+		if e.Src < 0 || e.Src >= n || e.Dst < 0 || e.Dst >= n {
+			return nil, fmt.Errorf("error: edge %v from %v to %v is out of range for %v vertices", i, e.Src, e.Dst, n)
+		}
+		c.offsets[e.Src+1]++
+		if o.Undirected && e.Src != e.Dst {
+			c.offsets[e.Dst+1]++
+		}
+	}
+	for u := 0; u < n; u++ {
+		c.offsets[u+1] += c.offsets[u]
+	}
+
+	// a counting sort by source keeps repeated edges in the order given
+	rows := make([]Edge[W], c.offsets[n])
+	next := slices.Clone(c.offsets[:n])
+	for _, e := range edges {
+		rows[next[e.Src]] = e
+		next[e.Src]++
+		if o.Undirected && e.Src != e.Dst {
+			rows[next[e.Dst]] = Edge[W]{e.Dst, e.Src, e.Weight}
+			next[e.Dst]++
+		}
+	}
+
+	start := 0
+	for u := 0; u < n; u++ {
+		row := rows[start:c.offsets[u+1]]
+		start = c.offsets[u+1]
+		slices.SortStableFunc(row, func(a, b Edge[W]) int {
+			return cmp.Compare(a.Dst, b.Dst)
+		})
+		for i, e := range row {
+			if i+1 < len(row) && row[i+1].Dst == e.Dst {
+				continue
+			}
+			c.targets = append(c.targets, e.Dst)
+			c.weights = append(c.weights, e.Weight)
+			if !c.undirected || u <= e.Dst {
+				c.totalEdges++
+			}
+		}
+		c.offsets[u+1] = len(c.targets)
+	}
+	return c, nil
+}
+
+func (c *Graph[W]) Name() string {
+	return compressedSparseRow
+}
+
+func (c *Graph[W]) Size() int {
+	return len(c.offsets) - 1
+}
+
+func (c *Graph[W]) Empty() bool {
+	return c.totalEdges == 0
+}
+
+func (c *Graph[W]) Values() []int {
+	vs := []int{}
+	for i := 0; i < c.Size(); i++ {
+		vs = append(vs, i)
+	}
+	return vs
+}
+
+func (c *Graph[W]) String() string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "total edges: %v, total vertices: %v, undirected: %v\n", c.totalEdges, c.Size(), c.undirected)
+	for u := 0; u < c.Size(); u++ {
+		fmt.Fprintf(&b, "%v:", u)
+		for v, w := range c.Edges(u) {
+			fmt.Fprintf(&b, " (--%v-> %v)", w, v)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Reset does nothing, as a Graph cannot change.
+func (c *Graph[W]) Reset() {}
+
+// TotalEdges returns the number of edges.
+func (c *Graph[W]) TotalEdges() int {
+	return c.totalEdges
+}
+
+func (c *Graph[W]) Adjacent(v1, v2 int) bool {
+	_, ok := c.find(v1, v2)
+	return ok
+}
+
+// Neighbors returns a copy of the sorted neighbours of v. Edges reads them
+// without copying.
+func (c *Graph[W]) Neighbors(v int) []int {
+	return slices.Clone(c.targets[c.offsets[v]:c.offsets[v+1]])
+}
+
+// Edges yields the neighbours of v in order with the weight of the edge to
+// each.
+func (c *Graph[W]) Edges(v int) iter.Seq2[int, W] {
+	return func(yield func(int, W) bool) {
+		for i := c.offsets[v]; i < c.offsets[v+1]; i++ {
+			if !yield(c.targets[i], c.weights[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Degree returns the number of edges out of v.
+func (c *Graph[W]) Degree(v int) int {
+	return c.offsets[v+1] - c.offsets[v]
+}
+
+// Transpose returns a new Graph with every edge reversed. Its neighbour lists
+// come out sorted by a counting sort, in O(V+E) time.
+func (c *Graph[W]) Transpose() graphs.WeightedGraph[W] {
+	if c.undirected {
+		return c
+	}
+	n := c.Size()
+	t := &Graph[W]{
+		totalEdges: c.totalEdges,
+		offsets:    make([]int, n+1),
+		targets:    make([]int, len(c.targets)),
+		weights:    make([]W, len(c.weights)),
+		undirected: c.undirected,
+	}
+	for _, v := range c.targets {
+		t.offsets[v+1]++
+	}
+	for v := 0; v < n; v++ {
+		t.offsets[v+1] += t.offsets[v]
+	}
+	next := slices.Clone(t.offsets[:n])
+	for u := 0; u < n; u++ {
+		for i := c.offsets[u]; i < c.offsets[u+1]; i++ {
+			v := c.targets[i]
+			t.targets[next[v]] = u
+			t.weights[next[v]] = c.weights[i]
+			next[v]++
+		}
+	}
+	return t
+}
+
+func (c *Graph[W]) Weight(src, dst int) (W, bool) {
+	if i, ok := c.find(src, dst); ok {
+		return c.weights[i], true
+	}
+	return 0, false
+}
+
+func (c *Graph[W]) Undirected() bool {
+	return c.undirected
+}
+
+// AddVertex does nothing, as a Graph cannot change.
+func (c *Graph[W]) AddVertex() {}
+
+// RemoveVertex reports false, as a Graph cannot change.
+func (c *Graph[W]) RemoveVertex(v int) bool {
+	return false
+}
+
+// AddEdge reports false, as a Graph cannot change.
+func (c *Graph[W]) AddEdge(src, dst int, weight W) bool {
+	return false
+}
+
+// UpdateEdge reports false, as a Graph cannot change.
+func (c *Graph[W]) UpdateEdge(src, dst int, weight W) bool {
+	return false
+}
+
+// RemoveEdge reports false, as a Graph cannot change.
+func (c *Graph[W]) RemoveEdge(src, dst int) bool {
+	return false
+}
+
+// find returns the index in targets of the edge from src to dst.
+func (c *Graph[W]) find(src, dst int) (int, bool) {
+	if src < 0 || src >= c.Size() {
+		return 0, false
+	}
+	lo, hi := c.offsets[src], c.offsets[src+1]
+	i, ok := slices.BinarySearch(c.targets[lo:hi], dst)
+	return lo + i, ok
+}
@@ -0,0 +1,212 @@
+package csr_test
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/csr"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func random(rng *rand.Rand, n, m int, undirected bool) (graphs.Graph, []csr.Edge[int]) {
+	g := adjacencylist.New(graphs.Options{TotalVertices: uint32(n), Undirected: undirected})
+	edges := []csr.Edge[int]{}
+	for i := 0; i < m; i++ {
+		e := csr.Edge[int]{Src: rng.Intn(n), Dst: rng.Intn(n), Weight: 1 + rng.Intn(9)}
+		g.UpdateEdge(e.Src, e.Dst, e.Weight)
+		edges = append(edges, e)
+	}
+	return g, edges
+}
+
+// same checks that c has exactly the edges of g.
+func same(t *testing.T, c graphs.Graph, g graphs.Graph) {
+	t.Helper()
+	helpers.AssertEqual(t, c.Size(), g.Size())
+	helpers.AssertEqual(t, c.Empty(), g.Empty())
+	for u := 0; u < g.Size(); u++ {
+		want := g.Neighbors(u)
+		slices.Sort(want)
+		helpers.AssertEqual(t, helpers.ToString(c.Neighbors(u)), helpers.ToString(want))
+		for v := 0; v < g.Size(); v++ {
+			helpers.AssertEqual(t, c.Adjacent(u, v), g.Adjacent(u, v))
+			w1, ok1 := c.Weight(u, v)
+			w2, ok2 := g.Weight(u, v)
+			helpers.AssertEqual(t, ok1, ok2)
+			helpers.AssertEqual(t, w1, w2)
+		}
+	}
+}
+
+func TestFrom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 30; trial++ {
+		undirected := trial%2 == 0
+		n := 1 + rng.Intn(20)
+		g, edges := random(rng, n, rng.Intn(3*n), undirected)
+		o := graphs.Options{TotalVertices: uint32(n), Undirected: undirected}
+
+		c := csr.From(g)
+		same(t, c, g)
+		e, err := csr.FromEdges(o, edges...)
+		helpers.AssertEqual(t, err, nil)
+		same(t, e, g)
+		helpers.AssertEqual(t, c.TotalEdges(), e.TotalEdges())
+		same(t, c.Transpose(), g.Transpose())
+	}
+}
+
+func TestFromEdges(t *testing.T) {
+	o := graphs.Options{TotalVertices: 3}
+	c, err := csr.FromEdges(o, csr.Edge[float64]{0, 2, 1.5}, csr.Edge[float64]{0, 1, 2}, csr.Edge[float64]{0, 2, 0.5})
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, helpers.ToString(c.Neighbors(0)), "[1 2]")
+	helpers.AssertEqual(t, c.TotalEdges(), 2)
+	w, _ := c.Weight(0, 2)
+	helpers.AssertEqual(t, w, 0.5)
+
+	_, err = csr.FromEdges(o, csr.Edge[float64]{0, 3, 1})
+	helpers.Assert(t, err != nil)
+}
+
+func TestImmutable(t *testing.T) {
+	c, _ := csr.FromEdges(graphs.Options{TotalVertices: 2}, csr.Edge[int]{0, 1, 1})
+	c.AddVertex()
+	c.Reset()
+	helpers.Assert(t, !c.AddEdge(1, 0, 1))
+	helpers.Assert(t, !c.UpdateEdge(0, 1, 2))
+	helpers.Assert(t, !c.RemoveEdge(0, 1))
+	helpers.Assert(t, !c.RemoveVertex(0))
+	helpers.AssertEqual(t, c.Size(), 2)
+	w, _ := c.Weight(0, 1)
+	helpers.AssertEqual(t, w, 1)
+}
+
+func TestAlgorithms(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	g, _ := random(rng, 50, 150, false)
+	c := csr.From(g)
+
+	want := dijkstra.Run(g, 0)
+	for v, got := range dijkstra.Run(c, 0) {
+		helpers.AssertEqual(t, got.Dist, want[v].Dist)
+	}
+
+	sorted := func(components [][]int) string {
+		for _, c := range components {
+			slices.Sort(c)
+		}
+		slices.SortFunc(components, func(a, b []int) int { return a[0] - b[0] })
+		return helpers.ToString(components)
+	}
+	helpers.AssertEqual(t, sorted(scc.Run(c)), sorted(scc.Run(g)))
+}
+
+// traverse visits every vertex reachable from 0 breadth first and returns how
+// many there are.
+func traverse(g graphs.Topology) int {
+	seen := make([]bool, g.Size())
+	seen[0] = true
+	frontier := []int{0}
+	for i := 0; i < len(frontier); i++ {
+		for _, v := range g.Neighbors(frontier[i]) {
+			if !seen[v] {
+				seen[v] = true
+				frontier = append(frontier, v)
+			}
+		}
+	}
+	return len(frontier)
+}
+
+var sizes = []struct{ n, m int }{{1000, 10000}, {10000, 100000}}
+
+func BenchmarkBuild(b *testing.B) {
+	for _, s := range sizes {
+		g, edges := random(rand.New(rand.NewSource(1)), s.n, s.m, false)
+		o := graphs.Options{TotalVertices: uint32(s.n)}
+		b.Run(fmt.Sprintf("From/V=%v/E=%v", s.n, s.m), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				csr.From(g)
+			}
+		})
+		b.Run(fmt.Sprintf("FromEdges/V=%v/E=%v", s.n, s.m), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				csr.FromEdges(o, edges...)
+			}
+		})
+	}
+}
+
+// BenchmarkMemory reports the heap each representation keeps alive.
+func BenchmarkMemory(b *testing.B) {
+	heap := func(build func() any) float64 {
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		g := build()
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		runtime.KeepAlive(g)
+		return float64(after.HeapAlloc) - float64(before.HeapAlloc)
+	}
+	for _, s := range sizes {
+		_, edges := random(rand.New(rand.NewSource(1)), s.n, s.m, false)
+		o := graphs.Options{TotalVertices: uint32(s.n)}
+		b.Run(fmt.Sprintf("AdjacencyList/V=%v/E=%v", s.n, s.m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.ReportMetric(heap(func() any {
+					g := adjacencylist.New(o)
+					for _, e := range edges {
+						g.UpdateEdge(e.Src, e.Dst, e.Weight)
+					}
+					return g
+				}), "heap-bytes")
+			}
+		})
+		b.Run(fmt.Sprintf("CSR/V=%v/E=%v", s.n, s.m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.ReportMetric(heap(func() any {
+					c, _ := csr.FromEdges(o, edges...)
+					return c
+				}), "heap-bytes")
+			}
+		})
+	}
+}
+
+func BenchmarkTraversal(b *testing.B) {
+	for _, s := range sizes {
+		g, _ := random(rand.New(rand.NewSource(1)), s.n, s.m, false)
+		c := csr.From(g)
+		b.Run(fmt.Sprintf("AdjacencyList/V=%v/E=%v", s.n, s.m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				traverse(g)
+			}
+		})
+		b.Run(fmt.Sprintf("CSR/V=%v/E=%v", s.n, s.m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				traverse(c)
+			}
+		})
+		b.Run(fmt.Sprintf("CSREdges/V=%v/E=%v", s.n, s.m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				total := 0
+				for u := 0; u < c.Size(); u++ {
+					for _, w := range c.Edges(u) {
+						total += w
+					}
+				}
+			}
+		})
+	}
+}
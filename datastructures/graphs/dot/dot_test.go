@@ -0,0 +1,153 @@
+package dot_test
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/bfs"
+	"github.com/mhrdini/godsa/algorithms/graphs/dfs"
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/dot"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestWrite(t *testing.T) {
+	g := adjacencylist.New(graphs.Options{TotalVertices: 3, Undirected: true})
+	g.AddEdge(1, 0, 4)
+	g.AddEdge(1, 2, 5)
+	want := `graph "G" {
+	0;
+	1;
+	2;
+	0 -- 1 [label="4"];
+	1 -- 2 [label="5"];
+}
+`
+	helpers.AssertEqual(t, dot.String(g, dot.Options{WeightLabels: true}), want)
+
+	d := adjacencylist.New(graphs.Options{TotalVertices: 2})
+	d.AddEdge(0, 1, 1)
+	want = `digraph "deps" {
+	0 [label="say \"hi\""];
+	1 [label="b"];
+	0 -> 1;
+}
+`
+	labels := []string{`say "hi"`, "b"}
+	helpers.AssertEqual(t, dot.String(d, dot.Options{Name: "deps", Labels: func(v int) string { return labels[v] }}), want)
+}
+
+func TestWriteHighlights(t *testing.T) {
+	g := adjacencylist.New(graphs.Options{TotalVertices: 4})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 0, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(0, 2, 1)
+
+	out := dot.String(g, dot.Options{Paths: [][]int{{0, 1, 2}}, Components: scc.Run(g)})
+	helpers.Assert(t, strings.Contains(out, "\t0 -> 1 [color=red, penwidth=2];\n"))
+	helpers.Assert(t, strings.Contains(out, "\t0 -> 2;\n"))
+	helpers.Assert(t, strings.Contains(out, "\t2 [style=filled, fillcolor=lightpink, color=red, penwidth=2];\n"))
+	helpers.Assert(t, strings.Contains(out, "\t1 [style=filled, fillcolor=lightgreen, color=red, penwidth=2];\n"))
+	helpers.Assert(t, strings.Contains(out, "\t3 [style=filled, fillcolor=lightblue];\n"))
+
+	tree := bfs.Run(g, 0)
+	out = dot.String(g, dot.Options{Tree: tree})
+	helpers.Assert(t, strings.Contains(out, "\t0 -> 2 [style=bold];\n"))
+	helpers.Assert(t, strings.Contains(out, "\t1 -> 2 [style=dotted];\n"))
+	helpers.Assert(t, strings.Contains(out, `	2 [label="2\nd=1"];`))
+	helpers.Assert(t, strings.Contains(out, "\t3;\n"))
+
+	// dfs.Run stores finishing times in Dist
+	tree = dfs.Run(g, 0)
+	out = dot.String(g, dot.Options{Tree: tree, TreeLabel: "f"})
+	helpers.Assert(t, strings.Contains(out, "\t1 -> 2 [style=bold];\n"))
+	helpers.Assert(t, strings.Contains(out, "\t0 -> 2 [style=dotted];\n"))
+	helpers.Assert(t, strings.Contains(out, `	0 [label="0\nf=6"];`))
+	helpers.Assert(t, strings.Contains(out, `	2 [label="2\nf=4"];`))
+	helpers.Assert(t, strings.Contains(out, "\t3;\n"))
+}
+
+func TestRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 20; trial++ {
+		undirected := trial%2 == 0
+		n := 1 + rng.Intn(12)
+		g := adjacencymatrix.NewWeighted[float64](graphs.Options{TotalVertices: uint32(n), Undirected: undirected})
+		for i := 0; i < 2*n; i++ {
+			g.UpdateEdge(rng.Intn(n), rng.Intn(n), float64(1+rng.Intn(20))/4)
+		}
+
+		b := strings.Builder{}
+		helpers.AssertEqual(t, dot.Write(&b, g, dot.Options{WeightLabels: true}), nil)
+		h, names, err := dot.Read(strings.NewReader(b.String()), adjacencylist.NewWeighted[float64])
+		helpers.AssertEqual(t, err, nil)
+		helpers.AssertEqual(t, len(names), n)
+		helpers.AssertEqual(t, h.Undirected(), undirected)
+		helpers.AssertEqual(t, dot.String(h, dot.Options{WeightLabels: true}), b.String())
+	}
+}
+
+func TestRead(t *testing.T) {
+	src := `/* a pipeline */
+strict digraph pipeline {
+	graph [rankdir=LR]; node [shape=box]
+	rankdir = LR
+	# a preprocessor line
+	fetch -> build -> "unit test" [weight=3]
+	build -> lint [label="2"]  // a numeric label is a weight
+	lint -> deploy [label="slow"]
+	subgraph cluster_release { deploy; announce [label=<<b>done</b>>] }
+	"unit test" -> deploy; build -> lint [weight=-4];
+}`
+	g, names, err := dot.Read(strings.NewReader(src), adjacencylist.New)
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, fmt.Sprint(names), "[fetch build unit test lint deploy announce]")
+	helpers.Assert(t, !g.Undirected())
+
+	l, err := graphs.NewLabeled(g, names...)
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, fmt.Sprint(l.Neighbors("build")), "[unit test lint]")
+	for _, e := range []struct {
+		src, dst string
+		want     int
+	}{{"fetch", "build", 3}, {"build", "unit test", 3}, {"build", "lint", -4}, {"lint", "deploy", 1}, {"unit test", "deploy", 1}} {
+		w, ok := l.Weight(e.src, e.dst)
+		helpers.Assert(t, ok)
+		helpers.AssertEqual(t, w, e.want)
+	}
+
+	f, _, err := dot.Read(strings.NewReader("graph { a -- b -- c; c -- a [weight=2.5] }"), adjacencymatrix.NewWeighted[float64])
+	helpers.AssertEqual(t, err, nil)
+	helpers.Assert(t, f.Undirected())
+	w, _ := f.Weight(0, 2)
+	helpers.AssertEqual(t, w, 2.5)
+}
+
+func TestReadErrors(t *testing.T) {
+	testCases := []struct {
+		desc string
+		src  string
+		want string
+	}{
+		{"not a graph", "tree { a }", "line 1: expected graph or digraph"},
+		{"wrong edge", "graph {\n a -> b\n}", "line 2: -> in a graph"},
+		{"unclosed", "digraph { a -> b", "line 1: unexpected \"\""},
+		{"fractional weight", "digraph { a -> b [weight=1.5] }", "does not fit in int"},
+		{"bad weight", "digraph { a -> b [weight=x] }", "is not a number"},
+		{"port", "digraph { a:n -> b }", "ports are not supported"},
+		{"trailing", "digraph { } x", "after the graph"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, _, err := dot.Read(strings.NewReader(tc.src), adjacencylist.New)
+			helpers.Assert(t, err != nil)
+			helpers.Assert(t, strings.Contains(err.Error(), tc.want))
+		})
+	}
+}
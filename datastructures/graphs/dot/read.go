@@ -0,0 +1,408 @@
+package dot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Read parses a graph in DOT and builds it with newGraph, for example
+// adjacencylist.New or adjacencymatrix.NewWeighted[float64]. Nodes get ids in
+// the order they first appear; their DOT ids are returned in that order, so
+// graphs.NewLabeled can label the graph with them.
+//
+// The weight of an edge is its weight attribute, or else its label if that is
+// a number, or else 1. A repeated edge keeps the last weight. Attribute
+// statements, graph attributes and subgraph blocks are read and ignored
+// beyond the nodes and edges inside them, but subgraphs cannot be the end of
+// an edge and ports are not supported.
+func Read[W graphs.Number](r io.Reader, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], []string, error) {
+	p := &parser[W]{lexer: newLexer(r), ids: map[string]int{}}
+	if err := p.graph(); err != nil {
+		return nil, nil, err
+	}
+	g := newGraph(graphs.Options{TotalVertices: uint32(len(p.names)), Undirected: p.undirected})
+	for _, e := range p.edges {
+		g.UpdateEdge(e.src, e.dst, e.weight)
+	}
+	return g, p.names, nil
+}
+
+type kind int
+
+const (
+	eof kind = iota
+	id
+	punct // one of { } [ ] ; , = :
+	edgeOp
+)
+
+type token struct {
+	kind   kind
+	text   string
+	quoted bool
+	line   int
+}
+
+type lexer struct {
+	r         *bufio.Reader
+	line      int
+	lineStart bool // only white space has been read on this line
+	peek      *token
+	err       error
+}
+
+func newLexer(r io.Reader) *lexer {
+	return &lexer{r: bufio.NewReader(r), line: 1, lineStart: true}
+}
+
+func (l *lexer) read() (rune, bool) {
+	c, _, err := l.r.ReadRune()
+	if err != nil {
+		if err != io.EOF {
+			l.err = err
+		}
+		return 0, false
+	}
+	if c == '\n' {
+		l.line++
+		l.lineStart = true
+	} else if !unicode.IsSpace(c) {
+		l.lineStart = false
+	}
+	return c, true
+}
+
+func (l *lexer) unread(c rune) {
+	l.r.UnreadRune()
+	if c == '\n' {
+		l.line--
+	}
+}
+
+// follows reads the next character if it is c.
+func (l *lexer) follows(c byte) bool {
+	if b, err := l.r.Peek(1); err == nil && b[0] == c {
+		l.r.ReadByte()
+		return true
+	}
+	return false
+}
+
+// skip skips white space and comments, including lines starting with # that
+// are output by the C preprocessor.
+func (l *lexer) skip() {
+	for {
+		lineStart := l.lineStart
+		c, ok := l.read()
+		switch {
+		case !ok:
+			return
+		case unicode.IsSpace(c):
+		case c == '#' && lineStart, c == '/' && l.follows('/'):
+			l.r.ReadString('\n')
+			l.line++
+			l.lineStart = true
+		case c == '/' && l.follows('*'):
+			for prev, next := rune(0), rune(0); ; prev = next {
+				if next, ok = l.read(); !ok || prev == '*' && next == '/' {
+					break
+				}
+			}
+		default:
+			l.unread(c)
+			return
+		}
+	}
+}
+
+func (l *lexer) next() token {
+	if t := l.peek; t != nil {
+		l.peek = nil
+		return *t
+	}
+	l.skip()
+	line := l.line
+	c, ok := l.read()
+	switch {
+	case !ok:
+		return token{kind: eof, line: line}
+	case strings.ContainsRune("{}[];,=:", c):
+		return token{kind: punct, text: string(c), line: line}
+	case c == '-' && l.follows('>'):
+		return token{kind: edgeOp, text: "->", line: line}
+	case c == '-' && l.follows('-'):
+		return token{kind: edgeOp, text: "--", line: line}
+	case c == '"':
+		b := strings.Builder{}
+		for {
+			c, ok := l.read()
+			if !ok {
+				return token{kind: eof, line: line}
+			}
+			if c == '"' {
+				break
+			}
+			if c == '\\' {
+				if next, ok := l.read(); ok && next == '"' {
+					c = next
+				} else if ok {
+					b.WriteRune(c)
+					c = next
+				}
+			}
+			b.WriteRune(c)
+		}
+		return token{kind: id, text: b.String(), quoted: true, line: line}
+	case c == '<':
+		// an HTML string runs to the matching >
+		b := strings.Builder{}
+		for depth := 1; ; {
+			c, ok := l.read()
+			if !ok {
+				return token{kind: eof, line: line}
+			}
+			if c == '<' {
+				depth++
+			} else if c == '>' {
+				if depth--; depth == 0 {
+					break
+				}
+			}
+			b.WriteRune(c)
+		}
+		return token{kind: id, text: b.String(), quoted: true, line: line}
+	}
+	return l.word(c, line)
+}
+
+// word reads an unquoted id: a name or a number.
+func (l *lexer) word(first rune, line int) token {
+	b := strings.Builder{}
+	b.WriteRune(first)
+	for {
+		c, ok := l.read()
+		if !ok {
+			break
+		}
+		if !(c == '_' || c == '.' || unicode.IsLetter(c) || unicode.IsDigit(c)) {
+			l.unread(c)
+			break
+		}
+		b.WriteRune(c)
+	}
+	return token{kind: id, text: b.String(), line: line}
+}
+
+func (l *lexer) lookahead() token {
+	if l.peek == nil {
+		t := l.next()
+		l.peek = &t
+	}
+	return *l.peek
+}
+
+type parsedEdge[W graphs.Number] struct {
+	src, dst int
+	weight   W
+}
+
+type parser[W graphs.Number] struct {
+	*lexer
+	undirected bool
+	ids        map[string]int
+	names      []string
+	edges      []parsedEdge[W]
+}
+
+func (p *parser[W]) errorf(t token, format string, args ...any) error {
+	if p.lexer.err != nil {
+		return p.lexer.err
+	}
+	return fmt.Errorf("error: line %v: %v", t.line, fmt.Sprintf(format, args...))
+}
+
+// keyword reports whether t is the keyword k, which DOT matches regardless of
+// case.
+func keyword(t token, k string) bool {
+	return t.kind == id && !t.quoted && strings.EqualFold(t.text, k)
+}
+
+func (p *parser[W]) expect(text string) error {
+	if t := p.next(); t.kind != punct || t.text != text {
+		return p.errorf(t, "expected %q, found %q", text, t.text)
+	}
+	return nil
+}
+
+func (p *parser[W]) graph() error {
+	t := p.next()
+	if keyword(t, "strict") {
+		t = p.next()
+	}
+	switch {
+	case keyword(t, "graph"):
+		p.undirected = true
+	case keyword(t, "digraph"):
+	default:
+		return p.errorf(t, "expected graph or digraph, found %q", t.text)
+	}
+	if p.lookahead().kind == id {
+		p.next()
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	if err := p.statements(); err != nil {
+		return err
+	}
+	if t := p.next(); t.kind != eof {
+		return p.errorf(t, "unexpected %q after the graph", t.text)
+	}
+	return nil
+}
+
+// statements reads statements up to and including the closing brace.
+func (p *parser[W]) statements() error {
+	for {
+		t := p.next()
+		switch {
+		case t.kind == punct && t.text == "}":
+			return nil
+		case t.kind == punct && t.text == ";":
+		case t.kind == punct && t.text == "{":
+			if err := p.statements(); err != nil {
+				return err
+			}
+		case keyword(t, "subgraph"):
+			if p.lookahead().kind == id {
+				p.next()
+			}
+			if err := p.expect("{"); err != nil {
+				return err
+			}
+			if err := p.statements(); err != nil {
+				return err
+			}
+		case keyword(t, "graph"), keyword(t, "node"), keyword(t, "edge"):
+			if _, err := p.attributes(); err != nil {
+				return err
+			}
+		case t.kind == id:
+			if err := p.statement(t); err != nil {
+				return err
+			}
+		default:
+			return p.errorf(t, "unexpected %q", t.text)
+		}
+	}
+}
+
+// statement reads the rest of a node, edge or graph attribute statement that
+// starts with the id first.
+func (p *parser[W]) statement(first token) error {
+	if t := p.lookahead(); t.kind == punct && t.text == "=" {
+		p.next()
+		if v := p.next(); v.kind != id {
+			return p.errorf(v, "expected a value for %v", first.text)
+		}
+		return nil
+	}
+	if t := p.lookahead(); t.kind == punct && t.text == ":" {
+		return p.errorf(t, "ports are not supported")
+	}
+
+	chain := []int{p.node(first.text)}
+	for p.lookahead().kind == edgeOp {
+		op := p.next()
+		if p.undirected != (op.text == "--") {
+			return p.errorf(op, "%v in a %v", op.text, map[bool]string{true: "graph", false: "digraph"}[p.undirected])
+		}
+		t := p.next()
+		if t.kind != id {
+			return p.errorf(t, "expected a node after %v, found %q", op.text, t.text)
+		}
+		chain = append(chain, p.node(t.text))
+	}
+	attrs, err := p.attributes()
+	if err != nil {
+		return err
+	}
+	if len(chain) == 1 {
+		return nil
+	}
+	weight, err := p.weight(first, attrs)
+	if err != nil {
+		return err
+	}
+	for i := 1; i < len(chain); i++ {
+		p.edges = append(p.edges, parsedEdge[W]{chain[i-1], chain[i], weight})
+	}
+	return nil
+}
+
+// attributes reads any number of bracketed attribute lists.
+func (p *parser[W]) attributes() (map[string]string, error) {
+	attrs := map[string]string{}
+	for {
+		if t := p.lookahead(); t.kind != punct || t.text != "[" {
+			return attrs, nil
+		}
+		p.next()
+		for {
+			t := p.next()
+			switch {
+			case t.kind == punct && t.text == "]":
+			case t.kind == punct && (t.text == "," || t.text == ";"):
+				continue
+			case t.kind == id:
+				if err := p.expect("="); err != nil {
+					return nil, err
+				}
+				v := p.next()
+				if v.kind != id {
+					return nil, p.errorf(v, "expected a value for %v", t.text)
+				}
+				attrs[t.text] = v.text
+				continue
+			default:
+				return nil, p.errorf(t, "unexpected %q in attributes", t.text)
+			}
+			break
+		}
+	}
+}
+
+func (p *parser[W]) weight(at token, attrs map[string]string) (W, error) {
+	text, ok := attrs["weight"]
+	if !ok {
+		label, ok := attrs["label"]
+		if _, err := strconv.ParseFloat(label, 64); !ok || err != nil {
+			return 1, nil
+		}
+		text = label
+	}
+	x, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, p.errorf(at, "weight %q is not a number", text)
+	}
+	w := W(x)
+	if float64(w) != x {
+		return 0, p.errorf(at, "weight %v does not fit in %T", text, w)
+	}
+	return w, nil
+}
+
+func (p *parser[W]) node(name string) int {
+	if v, ok := p.ids[name]; ok {
+		return v
+	}
+	p.ids[name] = len(p.names)
+	p.names = append(p.names, name)
+	return len(p.names) - 1
+}
@@ -0,0 +1,168 @@
+package dot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	algorithms "github.com/mhrdini/godsa/algorithms/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// Graphviz DOT is a text format for graphs that tools such as dot, neato and
+// many editors render. Vertices are written with their ids as DOT node ids,
+// so Read gives them the same ids back.
+
+// Options controls what Write adds to the plain vertices and edges.
+type Options struct {
+	// Name is the name of the graph. It defaults to G.
+	Name string
+	// Labels returns the label of a vertex. Vertices are labelled with their
+	// ids if it is nil.
+	Labels func(v int) string
+	// WeightLabels labels every edge with its weight, which Read turns back
+	// into the weight.
+	WeightLabels bool
+	// Paths are drawn in red, vertices and edges between consecutive ones.
+	Paths [][]int
+	// Components, such as the result of scc.Run, fill the vertices of each
+	// with its own colour.
+	Components [][]int
+	// Tree, such as the result of bfs.Run or dfs.Run, draws the edge from
+	// every vertex's parent in bold and the other edges dotted, and adds the
+	// Dist of every reached vertex to its label.
+	Tree []*algorithms.Vertex
+	// TreeLabel names the Dist of the vertices of Tree in their labels. It
+	// defaults to d, for the distances of bfs.Run or dijkstra.Run; dfs.Run
+	// stores finishing times in Dist instead, which f suits.
+	TreeLabel string
+}
+
+var palette = []string{
+	"lightblue", "lightgreen", "lightpink", "lightyellow", "lavender",
+	"lightsalmon", "palegreen", "lightcyan", "wheat", "thistle",
+}
+
+// Write writes g to w in DOT.
+func Write[W graphs.Number](w io.Writer, g graphs.WeightedGraph[W], o Options) error {
+	b := bufio.NewWriter(w)
+	kind, op := "digraph", "->"
+	if g.Undirected() {
+		kind, op = "graph", "--"
+	}
+	name := o.Name
+	if name == "" {
+		name = "G"
+	}
+	fmt.Fprintf(b, "%v %v {\n", kind, quote(name))
+
+	onPath := map[int]bool{}
+	pathEdges := map[[2]int]bool{}
+	for _, p := range o.Paths {
+		for i, v := range p {
+			onPath[v] = true
+			if i > 0 {
+				pathEdges[key(g, p[i-1], v)] = true
+			}
+		}
+	}
+	component := map[int]int{}
+	for c, vs := range o.Components {
+		for _, v := range vs {
+			component[v] = c
+		}
+	}
+	treeLabel := o.TreeLabel
+	if treeLabel == "" {
+		treeLabel = "d"
+	}
+	treeEdges := map[[2]int]bool{}
+	for _, v := range o.Tree {
+		if v.Parent != nil {
+			treeEdges[key(g, v.Parent.Value, v.Value)] = true
+		}
+	}
+
+	for v := 0; v < g.Size(); v++ {
+		attrs := []string{}
+		label := ""
+		if o.Labels != nil {
+			label = o.Labels(v)
+		}
+		if o.Tree != nil && v < len(o.Tree) && o.Tree[v].Color != algorithms.White {
+			if label == "" {
+				label = fmt.Sprint(v)
+			}
+			label += fmt.Sprintf("\\n%v=%v", treeLabel, o.Tree[v].Dist)
+		}
+		if label != "" {
+			attrs = append(attrs, "label="+quote(label))
+		}
+		if c, ok := component[v]; ok {
+			attrs = append(attrs, "style=filled", "fillcolor="+palette[c%len(palette)])
+		}
+		if onPath[v] {
+			attrs = append(attrs, "color=red", "penwidth=2")
+		}
+		fmt.Fprintf(b, "\t%v%v;\n", v, list(attrs))
+	}
+
+	for u := 0; u < g.Size(); u++ {
+		neighbors := g.Neighbors(u)
+		slices.Sort(neighbors)
+		for _, v := range neighbors {
+			if g.Undirected() && v < u {
+				continue
+			}
+			attrs := []string{}
+			if o.WeightLabels {
+				weight, _ := g.Weight(u, v)
+				attrs = append(attrs, "label="+quote(fmt.Sprint(weight)))
+			}
+			if pathEdges[key(g, u, v)] {
+				attrs = append(attrs, "color=red", "penwidth=2")
+			}
+			if o.Tree != nil {
+				if treeEdges[key(g, u, v)] {
+					attrs = append(attrs, "style=bold")
+				} else {
+					attrs = append(attrs, "style=dotted")
+				}
+			}
+			fmt.Fprintf(b, "\t%v %v %v%v;\n", u, op, v, list(attrs))
+		}
+	}
+	b.WriteString("}\n")
+	return b.Flush()
+}
+
+// String returns g in DOT.
+func String[W graphs.Number](g graphs.WeightedGraph[W], o Options) string {
+	b := strings.Builder{}
+	Write(&b, g, o)
+	return b.String()
+}
+
+// key identifies an edge, whichever way round an undirected one is given.
+func key(g graphs.Topology, u, v int) [2]int {
+	if g.Undirected() && u > v {
+		u, v = v, u
+	}
+	return [2]int{u, v}
+}
+
+func list(attrs []string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(attrs, ", ") + "]"
+}
+
+// quote writes s as a DOT string, in which only quotes are escaped. Other
+// backslashes are left for Graphviz, which reads \n in a label as a line
+// break.
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
@@ -0,0 +1,278 @@
+package edgelist
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+const edgeList = "EdgeList"
+
+// Graph is a plain list of edges, ordered by id. It takes the least memory of
+// any representation and adding an edge is O(1), but every query scans the
+// list in O(E) time, apart from looking an edge up by id, which is O(log E).
+// It suits graphs that are mostly built and then streamed over, or converted
+// into another representation.
+//
+// Like a multigraph it allows parallel edges and self-loops, gives every edge
+// an id that is never reused, and shows the same simple graph through the
+// graphs.WeightedGraph methods: Neighbors lists every neighbour once, Weight
+// is the weight of the lightest edge between two vertices, UpdateEdge changes
+// the oldest one and RemoveEdge removes them all.
+type Graph[W graphs.Number] struct {
+	totalVertices int
+	edges         []Edge[W]
+	next          int // id of the next edge
+	undirected    bool
+}
+
+// Edge is an edge from Src to Dst. An undirected edge keeps the endpoints in
+// the order it was added with.
+type Edge[W graphs.Number] struct {
+	ID, Src, Dst int
+	Weight       W
+}
+
+func New(o graphs.Options) graphs.Graph {
+	return NewWeighted[int](o)
+}
+
+// NewWeighted returns a graph whose edge weights have type W.
+func NewWeighted[W graphs.Number](o graphs.Options) graphs.WeightedGraph[W] {
+	return &Graph[W]{
+		totalVertices: int(o.TotalVertices),
+		edges:         []Edge[W]{},
+		undirected:    o.Undirected,
+	}
+}
+
+func (e Edge[W]) String() string {
+	return fmt.Sprintf("#%v(%v --%v-> %v)", e.ID, e.Src, e.Weight, e.Dst)
+}
+
+func (g *Graph[W]) Name() string {
+	return edgeList
+}
+
+func (g *Graph[W]) Size() int {
+	return g.totalVertices
+}
+
+func (g *Graph[W]) Empty() bool {
+	return len(g.edges) == 0
+}
+
+func (g *Graph[W]) Values() []int {
+	vs := []int{}
+	for i := 0; i < g.Size(); i++ {
+		vs = append(vs, i)
+	}
+	return vs
+}
+
+func (g *Graph[W]) String() string {
+	return fmt.Sprintf("total edges: %v, total vertices: %v, undirected: %v\n%v", len(g.edges), g.totalVertices, g.undirected, g.edges)
+}
+
+func (g *Graph[W]) Reset() {
+	g.edges = []Edge[W]{}
+}
+
+// TotalEdges returns the number of edges, counting parallel ones.
+func (g *Graph[W]) TotalEdges() int {
+	return len(g.edges)
+}
+
+func (g *Graph[W]) Adjacent(v1, v2 int) bool {
+	return slices.ContainsFunc(g.edges, func(e Edge[W]) bool { return g.joins(e, v1, v2) })
+}
+
+// Neighbors returns every vertex v has an edge to once, in the order of the
+// oldest edge to each.
+func (g *Graph[W]) Neighbors(v int) []int {
+	vs := []int{}
+	for _, e := range g.edges {
+		u := e.Dst
+		if g.undirected && e.Dst == v {
+			u = e.Src
+		} else if e.Src != v {
+			continue
+		}
+		if !slices.Contains(vs, u) {
+			vs = append(vs, u)
+		}
+	}
+	return vs
+}
+
+// Transpose returns a new graph with every edge reversed. The edges keep
+// their ids.
+func (g *Graph[W]) Transpose() graphs.WeightedGraph[W] {
+	if g.undirected {
+		return g
+	}
+	t := &Graph[W]{
+		totalVertices: g.totalVertices,
+		edges:         make([]Edge[W], len(g.edges)),
+		next:          g.next,
+		undirected:    g.undirected,
+	}
+	for i, e := range g.edges {
+		t.edges[i] = Edge[W]{e.ID, e.Dst, e.Src, e.Weight}
+	}
+	return t
+}
+
+// Weight returns the weight of the lightest edge from src to dst.
+func (g *Graph[W]) Weight(src, dst int) (W, bool) {
+	var w W
+	found := false
+	for _, e := range g.edges {
+		if g.joins(e, src, dst) && (!found || e.Weight < w) {
+			w, found = e.Weight, true
+		}
+	}
+	return w, found
+}
+
+func (g *Graph[W]) Undirected() bool {
+	return g.undirected
+}
+
+func (g *Graph[W]) AddVertex() {
+	g.totalVertices++
+}
+
+// RemoveVertex removes v and its edges, and moves every vertex with a higher
+// id down by one. The ids of the other edges do not change.
+func (g *Graph[W]) RemoveVertex(v int) bool {
+	if !g.withinRange(v) {
+		return false
+	}
+	g.edges = slices.DeleteFunc(g.edges, func(e Edge[W]) bool { return e.Src == v || e.Dst == v })
+	for i := range g.edges {
+		if e := &g.edges[i]; e.Src > v {
+			e.Src--
+		}
+		if e := &g.edges[i]; e.Dst > v {
+			e.Dst--
+		}
+	}
+	g.totalVertices--
+	return true
+}
+
+// AddEdge adds a new edge from src to dst, even if there already is one.
+func (g *Graph[W]) AddEdge(src, dst int, weight W) bool {
+	return g.NewEdge(src, dst, weight) >= 0
+}
+
+// UpdateEdge sets the weight of the oldest edge from src to dst, adding an
+// edge if there is none.
+func (g *Graph[W]) UpdateEdge(src, dst int, weight W) bool {
+	i := slices.IndexFunc(g.edges, func(e Edge[W]) bool { return g.joins(e, src, dst) })
+	if i < 0 {
+		return g.AddEdge(src, dst, weight)
+	}
+	g.edges[i].Weight = weight
+	return false
+}
+
+// RemoveEdge removes every edge from src to dst.
+func (g *Graph[W]) RemoveEdge(src, dst int) bool {
+	n := len(g.edges)
+	g.edges = slices.DeleteFunc(g.edges, func(e Edge[W]) bool { return g.joins(e, src, dst) })
+	return len(g.edges) < n
+}
+
+// NewEdge adds an edge from src to dst and returns its id, or -1 if either
+// vertex does not exist.
+func (g *Graph[W]) NewEdge(src, dst int, weight W) int {
+	if !g.withinRange(src) || !g.withinRange(dst) {
+		return -1
+	}
+	id := g.next
+	g.next++
+	g.edges = append(g.edges, Edge[W]{id, src, dst, weight})
+	return id
+}
+
+// Edge returns the edge with the given id.
+func (g *Graph[W]) Edge(id int) (Edge[W], bool) {
+	if i, ok := g.find(id); ok {
+		return g.edges[i], true
+	}
+	return Edge[W]{}, false
+}
+
+// Edges returns every edge, ordered by id.
+func (g *Graph[W]) Edges() []Edge[W] {
+	return slices.Clone(g.edges)
+}
+
+// EdgeWeight returns the weight of the edge with the given id.
+func (g *Graph[W]) EdgeWeight(id int) (W, bool) {
+	if i, ok := g.find(id); ok {
+		return g.edges[i].Weight, true
+	}
+	return 0, false
+}
+
+// SetEdgeWeight changes the weight of the edge with the given id.
+func (g *Graph[W]) SetEdgeWeight(id int, weight W) bool {
+	i, ok := g.find(id)
+	if ok {
+		g.edges[i].Weight = weight
+	}
+	return ok
+}
+
+// RemoveEdgeID removes the edge with the given id.
+func (g *Graph[W]) RemoveEdgeID(id int) bool {
+	i, ok := g.find(id)
+	if ok {
+		g.edges = slices.Delete(g.edges, i, i+1)
+	}
+	return ok
+}
+
+// Between returns the ids of the edges from src to dst, oldest first.
+func (g *Graph[W]) Between(src, dst int) []int {
+	ids := []int{}
+	for _, e := range g.edges {
+		if g.joins(e, src, dst) {
+			ids = append(ids, e.ID)
+		}
+	}
+	return ids
+}
+
+// Incident returns the ids of the edges into or out of v, ordered by id.
+func (g *Graph[W]) Incident(v int) []int {
+	ids := []int{}
+	for _, e := range g.edges {
+		if e.Src == v || e.Dst == v {
+			ids = append(ids, e.ID)
+		}
+	}
+	return ids
+}
+
+// joins reports whether e leads from src to dst.
+func (g *Graph[W]) joins(e Edge[W], src, dst int) bool {
+	return e.Src == src && e.Dst == dst || g.undirected && e.Src == dst && e.Dst == src
+}
+
+// find returns the index of the edge with the given id. The edges are kept in
+// the order they were added, which is the order of their ids.
+func (g *Graph[W]) find(id int) (int, bool) {
+	return slices.BinarySearchFunc(g.edges, id, func(e Edge[W], id int) int {
+		return cmp.Compare(e.ID, id)
+	})
+}
+
+func (g *Graph[W]) withinRange(v int) bool {
+	return v >= 0 && v < g.totalVertices
+}
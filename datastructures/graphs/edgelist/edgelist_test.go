@@ -0,0 +1,72 @@
+package edgelist_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/edgelist"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func TestParallelEdges(t *testing.T) {
+	for _, undirected := range []bool{false, true} {
+		t.Run(fmt.Sprintf("undirected=%v", undirected), func(t *testing.T) {
+			g := edgelist.New(graphs.Options{TotalVertices: 4, Undirected: undirected}).(*edgelist.Graph[int])
+			a := g.NewEdge(0, 1, 5)
+			b := g.NewEdge(0, 1, 2)
+			c := g.NewEdge(1, 1, 7)
+			d := g.NewEdge(1, 2, 1)
+			helpers.AssertEqual(t, g.NewEdge(0, 4, 1), -1)
+			helpers.AssertEqual(t, helpers.ToString([]int{a, b, c, d}), "[0 1 2 3]")
+			helpers.AssertEqual(t, g.TotalEdges(), 4)
+
+			helpers.AssertEqual(t, helpers.ToString(g.Neighbors(0)), "[1]")
+			helpers.AssertEqual(t, helpers.ToString(g.Between(0, 1)), "[0 1]")
+			helpers.AssertEqual(t, len(g.Between(1, 0)) == 2, undirected)
+			helpers.AssertEqual(t, helpers.ToString(g.Incident(1)), "[0 1 2 3]")
+			w, _ := g.Weight(0, 1)
+			helpers.AssertEqual(t, w, 2)
+			w, _ = g.EdgeWeight(a)
+			helpers.AssertEqual(t, w, 5)
+			helpers.Assert(t, g.Adjacent(1, 1))
+
+			helpers.Assert(t, g.SetEdgeWeight(a, 1))
+			w, _ = g.Weight(0, 1)
+			helpers.AssertEqual(t, w, 1)
+			helpers.Assert(t, !g.UpdateEdge(0, 1, 9))
+			w, _ = g.EdgeWeight(a)
+			helpers.AssertEqual(t, w, 9)
+
+			helpers.Assert(t, g.RemoveEdgeID(b))
+			helpers.Assert(t, !g.RemoveEdgeID(b))
+			_, ok := g.EdgeWeight(b)
+			helpers.Assert(t, !ok)
+			helpers.Assert(t, g.Adjacent(0, 1))
+
+			// ids survive the renumbering of vertices
+			helpers.Assert(t, g.RemoveVertex(0))
+			helpers.AssertEqual(t, g.TotalEdges(), 2)
+			helpers.AssertEqual(t, helpers.ToString(g.Between(0, 1)), "[3]")
+			helpers.AssertEqual(t, helpers.ToString(g.Between(0, 0)), "[2]")
+			helpers.AssertEqual(t, g.NewEdge(0, 1, 1), 4)
+
+			helpers.Assert(t, g.RemoveEdge(0, 1))
+			helpers.AssertEqual(t, len(g.Between(0, 1)), 0)
+		})
+	}
+}
+
+func TestParallelEdgesShortestPath(t *testing.T) {
+	g := edgelist.New(graphs.Options{TotalVertices: 3}).(*edgelist.Graph[int])
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(0, 1, 3)
+	g.AddEdge(1, 2, 4)
+	g.AddEdge(1, 2, 8)
+	helpers.AssertEqual(t, dijkstra.Run(g, 0)[2].Dist, 7.0)
+
+	tr := g.Transpose().(*edgelist.Graph[int])
+	helpers.AssertEqual(t, helpers.ToString(tr.Between(1, 0)), "[0 1]")
+	helpers.AssertEqual(t, dijkstra.Run(tr, 2)[0].Dist, 7.0)
+}
@@ -0,0 +1,169 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// The kinds of problem a DIMACS file can describe. Vertices are counted from
+// 1 in all of them, and each line starts with a letter saying what it is:
+// c for a comment, p for the problem, then a for an arc or e for an edge.
+const (
+	// ShortestPath files have weighted arcs.
+	ShortestPath = "sp"
+	// MaxFlow files have arcs weighted by their capacities, and n lines
+	// naming the source and the sink.
+	MaxFlow = "max"
+	// Edge files, used for colouring and cliques, have unweighted undirected
+	// edges.
+	Edge = "edge"
+)
+
+// Problem is the problem line of a DIMACS file and the terminals of a max
+// flow problem.
+type Problem struct {
+	// Kind is ShortestPath, MaxFlow or Edge.
+	Kind         string
+	Source, Sink int
+}
+
+// ReadDIMACS reads a graph in one of the DIMACS formats. Shortest path and max
+// flow files give directed graphs, and edge files undirected graphs whose
+// edges weigh 1.
+func ReadDIMACS[W graphs.Number](r io.Reader, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], Problem, error) {
+	p := Problem{Source: -1, Sink: -1}
+	var g graphs.WeightedGraph[W]
+	n, m, read := 0, 0, 0
+	l := newLines(r, "c")
+	for {
+		fields, ok := l.next()
+		if !ok {
+			break
+		}
+		if g == nil && fields[0] != "p" {
+			return nil, p, l.errorf("expected the problem line, found %q", fields[0])
+		}
+		switch fields[0] {
+		case "p":
+			if g != nil {
+				return nil, p, l.errorf("a second problem line")
+			}
+			if len(fields) != 4 {
+				return nil, p, l.errorf("expected p, a problem, and the numbers of vertices and edges")
+			}
+			p.Kind = fields[1]
+			if p.Kind != ShortestPath && p.Kind != MaxFlow && p.Kind != Edge {
+				return nil, p, l.errorf("problem %q is not supported", p.Kind)
+			}
+			var err1, err2 error
+			n, err1 = strconv.Atoi(fields[2])
+			m, err2 = strconv.Atoi(fields[3])
+			if err1 != nil || err2 != nil || n < 0 || m < 0 {
+				return nil, p, l.errorf("expected the numbers of vertices and edges, found %v and %v", fields[2], fields[3])
+			}
+			g = newGraph(graphs.Options{TotalVertices: uint32(n), Undirected: p.Kind == Edge})
+		case "n":
+			if p.Kind != MaxFlow {
+				return nil, p, l.errorf("n lines are only in max flow problems")
+			}
+			if len(fields) != 3 {
+				return nil, p, l.errorf("expected n, a vertex and s or t")
+			}
+			v, err := parseVertex(fields[1], 1, n)
+			if err != nil {
+				return nil, p, l.errorf("%v", err)
+			}
+			switch fields[2] {
+			case "s":
+				p.Source = v
+			case "t":
+				p.Sink = v
+			default:
+				return nil, p, l.errorf("expected s or t, found %q", fields[2])
+			}
+		case "a", "e":
+			isEdge := fields[0] == "e"
+			if isEdge != (p.Kind == Edge) {
+				return nil, p, l.errorf("%v lines are not in %v problems", fields[0], p.Kind)
+			}
+			if isEdge && len(fields) != 3 {
+				return nil, p, l.errorf("expected e and two vertices")
+			}
+			if !isEdge && len(fields) != 4 {
+				return nil, p, l.errorf("expected a, two vertices and a weight")
+			}
+			src, err := parseVertex(fields[1], 1, n)
+			if err != nil {
+				return nil, p, l.errorf("%v", err)
+			}
+			dst, err := parseVertex(fields[2], 1, n)
+			if err != nil {
+				return nil, p, l.errorf("%v", err)
+			}
+			weight := W(1)
+			if !isEdge {
+				if weight, err = parseWeight[W](fields[3]); err != nil {
+					return nil, p, l.errorf("%v", err)
+				}
+			}
+			g.UpdateEdge(src, dst, weight)
+			read++
+		default:
+			return nil, p, l.errorf("unknown line %q", fields[0])
+		}
+	}
+	if err := l.err(); err != nil {
+		return nil, p, err
+	}
+	if g == nil {
+		return nil, p, l.errorf("no problem line")
+	}
+	if read != m {
+		return nil, p, l.errorf("the problem has %v edges but %v were read", m, read)
+	}
+	if p.Kind == MaxFlow && (p.Source < 0 || p.Sink < 0) {
+		return nil, p, l.errorf("the max flow problem has no source or no sink")
+	}
+	return g, p, nil
+}
+
+// WriteDIMACS writes g to w as a DIMACS problem of the kind p.Kind. Shortest
+// path and max flow problems only have arcs, so every undirected edge is
+// written as an arc each way, and the graph is read back directed. Edge
+// problems are only for undirected graphs and lose the weights.
+func WriteDIMACS[W graphs.Number](w io.Writer, g graphs.WeightedGraph[W], p Problem) error {
+	switch p.Kind {
+	case ShortestPath:
+	case MaxFlow:
+		if p.Source < 0 || p.Source >= g.Size() || p.Sink < 0 || p.Sink >= g.Size() {
+			return fmt.Errorf("error: source %v or sink %v is out of range", p.Source, p.Sink)
+		}
+	case Edge:
+		if !g.Undirected() {
+			return fmt.Errorf("error: graph is directed")
+		}
+	default:
+		return fmt.Errorf("error: problem %q is not supported", p.Kind)
+	}
+
+	b := bufio.NewWriter(w)
+	if p.Kind == Edge {
+		fmt.Fprintf(b, "p %v %v %v\n", p.Kind, g.Size(), count(edges(g)))
+		for e := range edges(g) {
+			fmt.Fprintf(b, "e %v %v\n", e.src+1, e.dst+1)
+		}
+		return b.Flush()
+	}
+	fmt.Fprintf(b, "p %v %v %v\n", p.Kind, g.Size(), count(arcs(g)))
+	if p.Kind == MaxFlow {
+		fmt.Fprintf(b, "n %v s\nn %v t\n", p.Source+1, p.Sink+1)
+	}
+	for e := range arcs(g) {
+		fmt.Fprintf(b, "a %v %v %v\n", e.src+1, e.dst+1, e.weight)
+	}
+	return b.Flush()
+}
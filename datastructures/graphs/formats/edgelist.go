@@ -0,0 +1,68 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// ReadEdgeList reads a graph with one edge on each line: the ids of its
+// source and destination, counted from 0, and optionally its weight, which is
+// otherwise 1. Fields are separated by white space and lines starting with #
+// or % are comments. A repeated edge keeps the last weight.
+//
+// The graph is built with newGraph(o) and grows to fit the largest id read.
+// An edge list cannot name isolated vertices above its largest id, so they
+// are only kept if o.TotalVertices counts them. Growing a graph one vertex at
+// a time is slow for some graphs, such as adjacency matrices, so
+// o.TotalVertices should be given whenever it is known.
+func ReadEdgeList[W graphs.Number](r io.Reader, o graphs.Options, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], error) {
+	g := newGraph(o)
+	l := newLines(r, "#%")
+	for {
+		fields, ok := l.next()
+		if !ok {
+			break
+		}
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, l.errorf("expected a source, a destination and an optional weight, found %v fields", len(fields))
+		}
+		var ends [2]int
+		for i := range ends {
+			v, err := parseVertex(fields[i], 0, -1)
+			if err != nil {
+				return nil, l.errorf("%v", err)
+			}
+			for g.Size() <= v {
+				g.AddVertex()
+			}
+			ends[i] = v
+		}
+		weight := W(1)
+		if len(fields) == 3 {
+			w, err := parseWeight[W](fields[2])
+			if err != nil {
+				return nil, l.errorf("%v", err)
+			}
+			weight = w
+		}
+		g.UpdateEdge(ends[0], ends[1], weight)
+	}
+	if err := l.err(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// WriteEdgeList writes the edges of g to w, one on each line with its weight.
+// It writes nothing for isolated vertices, so the number of vertices and
+// whether g is undirected have to be passed to ReadEdgeList again.
+func WriteEdgeList[W graphs.Number](w io.Writer, g graphs.WeightedGraph[W]) error {
+	b := bufio.NewWriter(w)
+	for e := range edges(g) {
+		fmt.Fprintf(b, "%v %v %v\n", e.src, e.dst, e.weight)
+	}
+	return b.Flush()
+}
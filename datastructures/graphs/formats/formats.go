@@ -0,0 +1,143 @@
+// Package formats reads and writes graphs in the file formats of other tools:
+// plain edge lists, DIMACS, Matrix Market, GraphML and JSON node-link data.
+//
+// Readers take the constructor of the graph to build, for example
+// adjacencylist.New or adjacencymatrix.NewWeighted[float64], and add vertices
+// and edges to it as they are read, so a file is never held in memory beside
+// the graph built from it. Writers list the vertices 0..n-1 and the edges of
+// each vertex in order, each undirected edge once from its smaller end.
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+type edge[W graphs.Number] struct {
+	src, dst int
+	weight   W
+}
+
+// arcs lists every edge of g from each end it can be followed from.
+func arcs[W graphs.Number](g graphs.WeightedGraph[W]) iter.Seq[edge[W]] {
+	return func(yield func(edge[W]) bool) {
+		for u := 0; u < g.Size(); u++ {
+			neighbors := g.Neighbors(u)
+			slices.Sort(neighbors)
+			for _, v := range neighbors {
+				w, _ := g.Weight(u, v)
+				if !yield(edge[W]{u, v, w}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// edges lists the edges of g, each undirected edge once from its smaller end.
+func edges[W graphs.Number](g graphs.WeightedGraph[W]) iter.Seq[edge[W]] {
+	return func(yield func(edge[W]) bool) {
+		for e := range arcs(g) {
+			if g.Undirected() && e.dst < e.src {
+				continue
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func count[W graphs.Number](es iter.Seq[edge[W]]) int {
+	n := 0
+	for range es {
+		n++
+	}
+	return n
+}
+
+// integral reports whether W is an integer type.
+func integral[W graphs.Number]() bool {
+	half := 0.5
+	return W(half) == 0
+}
+
+// parseWeight parses text as a weight of type W, which it has to fit exactly.
+func parseWeight[W graphs.Number](text string) (W, error) {
+	x, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("weight %q is not a number", text)
+	}
+	w := W(x)
+	if float64(w) != x {
+		return 0, fmt.Errorf("weight %v does not fit in %T", text, w)
+	}
+	return w, nil
+}
+
+// parseVertex parses text as a vertex id counted from base and returns it
+// counted from 0. Ids at least n are out of range unless n is negative.
+func parseVertex(text string, base, n int) (int, error) {
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("vertex %q is not an integer", text)
+	}
+	if v < base || n >= 0 && v-base >= n {
+		return 0, fmt.Errorf("vertex %v is out of range", v)
+	}
+	return v - base, nil
+}
+
+// trim removes the vertices of g from n on, which a size hint counted but no
+// node of the file used.
+func trim[W graphs.Number](g graphs.WeightedGraph[W], n int) {
+	for g.Size() > n {
+		g.RemoveVertex(g.Size() - 1)
+	}
+}
+
+// lines reads a line-based format one line at a time and counts the lines for
+// errors.
+type lines struct {
+	s        *bufio.Scanner
+	line     int
+	comments string
+}
+
+// newLines reads r skipping blank lines and lines that start with any of the
+// characters in comments.
+func newLines(r io.Reader, comments string) *lines {
+	return &lines{s: bufio.NewScanner(r), comments: comments}
+}
+
+// next returns the fields of the next line that is not blank or a comment.
+func (l *lines) next() ([]string, bool) {
+	for l.s.Scan() {
+		l.line++
+		text := strings.TrimSpace(l.s.Text())
+		if text == "" || strings.ContainsRune(l.comments, rune(text[0])) {
+			continue
+		}
+		return strings.Fields(text), true
+	}
+	return nil, false
+}
+
+func (l *lines) errorf(format string, args ...any) error {
+	return fmt.Errorf("error: line %v: %v", l.line, fmt.Sprintf(format, args...))
+}
+
+// err returns the error that stopped the lines early, if any.
+func (l *lines) err() error {
+	if err := l.s.Err(); err != nil {
+		return fmt.Errorf("error: line %v: %v", l.line+1, err)
+	}
+	return nil
+}
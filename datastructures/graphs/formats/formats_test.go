@@ -0,0 +1,284 @@
+package formats_test
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymap"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/formats"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+type edge[W graphs.Number] struct {
+	src, dst int
+	weight   W
+}
+
+// edges lists the edges of g with their weights.
+func edges[W graphs.Number](g graphs.WeightedGraph[W]) string {
+	es := []edge[W]{}
+	for u := 0; u < g.Size(); u++ {
+		for v := 0; v < g.Size(); v++ {
+			if w, ok := g.Weight(u, v); ok && (!g.Undirected() || u <= v) {
+				es = append(es, edge[W]{u, v, w})
+			}
+		}
+	}
+	return fmt.Sprint(es)
+}
+
+func random[W graphs.Number](rng *rand.Rand, n int, undirected bool, weight func() W) graphs.WeightedGraph[W] {
+	g := adjacencylist.NewWeighted[W](graphs.Options{TotalVertices: uint32(n), Undirected: undirected})
+	for i := 0; i < 2*n; i++ {
+		g.UpdateEdge(rng.Intn(n), rng.Intn(n), weight())
+	}
+	return g
+}
+
+// roundTrip writes g and reads it back with each of the constructors.
+func roundTrip[W graphs.Number](t *testing.T, g graphs.WeightedGraph[W], write func(io.Writer, graphs.WeightedGraph[W]) error, read func(io.Reader, func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], error)) {
+	t.Helper()
+	b := strings.Builder{}
+	helpers.AssertEqual(t, write(&b, g), nil)
+	for _, newGraph := range []func(graphs.Options) graphs.WeightedGraph[W]{adjacencylist.NewWeighted[W], adjacencymatrix.NewWeighted[W], adjacencymap.NewWeighted[W]} {
+		h, err := read(strings.NewReader(b.String()), newGraph)
+		helpers.AssertEqual(t, err, nil)
+		helpers.AssertEqual(t, h.Size(), g.Size())
+		helpers.AssertEqual(t, h.Undirected(), g.Undirected())
+		helpers.AssertEqual(t, edges(h), edges(g))
+	}
+}
+
+func testRoundTrips[W graphs.Number](t *testing.T, weight func(*rand.Rand) W) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 20; trial++ {
+		n := 1 + rng.Intn(12)
+		undirected := trial%2 == 0
+		g := random(rng, n, undirected, func() W { return weight(rng) })
+
+		roundTrip(t, g, formats.WriteEdgeList[W], func(r io.Reader, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], error) {
+			return formats.ReadEdgeList(r, graphs.Options{TotalVertices: uint32(n), Undirected: undirected}, newGraph)
+		})
+		roundTrip(t, g, formats.WriteMatrixMarket[W], formats.ReadMatrixMarket[W])
+		roundTrip(t, g, formats.WriteGraphML[W], func(r io.Reader, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], error) {
+			h, _, err := formats.ReadGraphML(r, graphs.Options{}, newGraph)
+			return h, err
+		})
+		roundTrip(t, g, formats.WriteJSON[W], func(r io.Reader, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], error) {
+			h, _, err := formats.ReadJSON(r, graphs.Options{}, newGraph)
+			return h, err
+		})
+		if !undirected {
+			p := formats.Problem{Kind: formats.MaxFlow, Source: 0, Sink: n - 1}
+			roundTrip(t, g, func(w io.Writer, g graphs.WeightedGraph[W]) error { return formats.WriteDIMACS(w, g, p) }, func(r io.Reader, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], error) {
+				h, q, err := formats.ReadDIMACS(r, newGraph)
+				helpers.AssertEqual(t, q, p)
+				return h, err
+			})
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	// adjacency matrices cannot store edges that weigh 0
+	testRoundTrips(t, func(rng *rand.Rand) int {
+		w := rng.Intn(40) - 20
+		if w >= 0 {
+			w++
+		}
+		return w
+	})
+	testRoundTrips(t, func(rng *rand.Rand) float64 { return float64(1+rng.Intn(80)) / 8 })
+}
+
+func TestLargeRoundTrip(t *testing.T) {
+	// with the number of vertices known up front, an adjacency matrix is
+	// allocated once instead of being copied for every node
+	n := 1000
+	g := random(rand.New(rand.NewSource(2)), n, true, func() int { return 1 })
+	for _, format := range []struct {
+		name  string
+		write func(io.Writer, graphs.WeightedGraph[int]) error
+		read  func(io.Reader, graphs.Options, func(graphs.Options) graphs.WeightedGraph[int]) (graphs.WeightedGraph[int], []string, error)
+	}{
+		{"GraphML", formats.WriteGraphML[int], formats.ReadGraphML[int]},
+		{"JSON", formats.WriteJSON[int], formats.ReadJSON[int]},
+	} {
+		t.Run(format.name, func(t *testing.T) {
+			b := strings.Builder{}
+			helpers.AssertEqual(t, format.write(&b, g), nil)
+			// a hint that is too large is trimmed to the nodes read
+			for _, hint := range []int{n, n + 3} {
+				h, names, err := format.read(strings.NewReader(b.String()), graphs.Options{TotalVertices: uint32(hint)}, adjacencymatrix.NewWeighted[int])
+				helpers.AssertEqual(t, err, nil)
+				helpers.AssertEqual(t, len(names), n)
+				helpers.AssertEqual(t, h.Size(), n)
+				helpers.AssertEqual(t, edges(h), edges(g))
+			}
+		})
+	}
+}
+
+func TestReadEdgeList(t *testing.T) {
+	src := "# a comment\n0 1\n1 3 2.5\n\n% another\n3 1 4\n1 3 -1\n"
+	g, err := formats.ReadEdgeList(strings.NewReader(src), graphs.Options{}, adjacencymatrix.NewWeighted[float64])
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, g.Size(), 4)
+	helpers.AssertEqual(t, edges(g), "[{0 1 1} {1 3 -1} {3 1 4}]")
+
+	g, err = formats.ReadEdgeList(strings.NewReader(src), graphs.Options{TotalVertices: 6, Undirected: true}, adjacencymatrix.NewWeighted[float64])
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, g.Size(), 6)
+	helpers.AssertEqual(t, edges(g), "[{0 1 1} {1 3 -1}]")
+}
+
+func TestReadDIMACS(t *testing.T) {
+	src := `c the example from the DIMACS shortest path challenge
+p sp 4 5
+a 1 2 3
+a 2 3 4
+a 3 1 -2
+a 2 4 1
+a 2 3 5
+`
+	g, p, err := formats.ReadDIMACS(strings.NewReader(src), adjacencylist.New)
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, p.Kind, formats.ShortestPath)
+	helpers.Assert(t, !g.Undirected())
+	helpers.AssertEqual(t, edges(g), "[{0 1 3} {1 2 5} {1 3 1} {2 0 -2}]")
+
+	g, p, err = formats.ReadDIMACS(strings.NewReader("p edge 3 2\ne 1 2\ne 3 2\n"), adjacencylist.New)
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, p.Kind, formats.Edge)
+	helpers.Assert(t, g.Undirected())
+	helpers.AssertEqual(t, edges(g), "[{0 1 1} {1 2 1}]")
+
+	b := strings.Builder{}
+	helpers.AssertEqual(t, formats.WriteDIMACS(&b, g, p), nil)
+	helpers.AssertEqual(t, b.String(), "p edge 3 2\ne 1 2\ne 2 3\n")
+	b.Reset()
+	helpers.AssertEqual(t, formats.WriteDIMACS(&b, g, formats.Problem{Kind: formats.ShortestPath}), nil)
+	helpers.AssertEqual(t, b.String(), "p sp 3 4\na 1 2 1\na 2 1 1\na 2 3 1\na 3 2 1\n")
+}
+
+func TestReadMatrixMarket(t *testing.T) {
+	src := `%%MatrixMarket matrix coordinate pattern symmetric
+% the path 1 - 2 - 3 with a loop on 3
+3 3 3
+2 1
+3 2
+3 3
+`
+	g, err := formats.ReadMatrixMarket(strings.NewReader(src), adjacencylist.New)
+	helpers.AssertEqual(t, err, nil)
+	helpers.Assert(t, g.Undirected())
+	helpers.AssertEqual(t, edges(g), "[{0 1 1} {1 2 1} {2 2 1}]")
+
+	b := strings.Builder{}
+	helpers.AssertEqual(t, formats.WriteMatrixMarket(&b, g), nil)
+	helpers.AssertEqual(t, b.String(), "%%MatrixMarket matrix coordinate integer symmetric\n3 3 3\n2 1 1\n3 2 1\n3 3 1\n")
+}
+
+func TestReadGraphML(t *testing.T) {
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="color" attr.type="string"/>
+  <key id="d1" for="edge" attr.name="weight" attr.type="double"><default>2</default></key>
+  <graph id="G" edgedefault="directed">
+    <node id="a"><data key="d0">green</data></node>
+    <node id="b"/>
+    <edge source="a" target="b"><data key="d1"> 0.5 </data></edge>
+    <edge source="b" target="c"/>
+  </graph>
+</graphml>`
+	g, names, err := formats.ReadGraphML(strings.NewReader(src), graphs.Options{}, adjacencylist.NewWeighted[float64])
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, fmt.Sprint(names), "[a b c]")
+	helpers.AssertEqual(t, edges(g), "[{0 1 0.5} {1 2 2}]")
+
+	l, err := graphs.NewLabeled(g, names...)
+	helpers.AssertEqual(t, err, nil)
+	w, ok := l.Weight("b", "c")
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, w, 2.0)
+}
+
+func TestReadJSON(t *testing.T) {
+	src := `{"graph": {"name": "friends"}, "nodes": [{"id": "ann", "age": 30}, {"id": 7}],
+		"links": [{"source": "ann", "target": 7, "weight": 3}, {"source": 7, "target": "bob"}]}`
+	g, names, err := formats.ReadJSON(strings.NewReader(src), graphs.Options{}, adjacencylist.New)
+	helpers.AssertEqual(t, err, nil)
+	helpers.Assert(t, g.Undirected())
+	helpers.AssertEqual(t, fmt.Sprint(names), "[ann 7 bob]")
+	helpers.AssertEqual(t, edges(g), "[{0 1 3} {1 2 1}]")
+
+	g, _, err = formats.ReadJSON(strings.NewReader(`{"directed": true, "edges": [{"source": 0, "target": 1}]}`), graphs.Options{}, adjacencylist.New)
+	helpers.AssertEqual(t, err, nil)
+	helpers.Assert(t, !g.Undirected())
+	helpers.AssertEqual(t, edges(g), "[{0 1 1}]")
+}
+
+func TestReadErrors(t *testing.T) {
+	testCases := []struct {
+		desc string
+		read func(io.Reader) error
+		src  string
+		want string
+	}{
+		{"edge list fields", readEdgeList, "0 1\n0 1 2 3\n", "line 2: expected a source"},
+		{"edge list vertex", readEdgeList, "0 -1\n", "vertex -1 is out of range"},
+		{"edge list weight", readEdgeList, "0 1 1.5\n", "does not fit in int"},
+		{"dimacs no problem", readDIMACS, "a 1 2 3\n", "line 1: expected the problem line"},
+		{"dimacs range", readDIMACS, "p sp 2 1\na 1 3 1\n", "line 2: vertex 3 is out of range"},
+		{"dimacs count", readDIMACS, "p sp 2 2\na 1 2 1\n", "has 2 edges but 1 were read"},
+		{"dimacs sink", readDIMACS, "p max 2 1\nn 1 s\na 1 2 1\n", "no source or no sink"},
+		{"dimacs arc in edge problem", readDIMACS, "p edge 2 1\na 1 2 1\n", "a lines are not in edge problems"},
+		{"matrix market header", readMatrixMarket, "%%MatrixMarket matrix array real general\n", "array matrices are not supported"},
+		{"matrix market shape", readMatrixMarket, "%%MatrixMarket matrix coordinate real general\n2 3 0\n", "a 2 by 3 matrix"},
+		{"matrix market weight", readMatrixMarket, "%%MatrixMarket matrix coordinate real general\n2 2 1\n1 2 x\n", `line 3: weight "x" is not a number`},
+		{"graphml hyperedge", readGraphML, `<graphml><graph edgedefault="undirected"><hyperedge/></graph></graphml>`, "hyperedges are not supported"},
+		{"graphml nested", readGraphML, `<graphml><graph><node id="a"><graph/></node></graph></graphml>`, "nested graphs"},
+		{"graphml direction", readGraphML, `<graphml><graph edgedefault="undirected"><edge source="a" target="b" directed="true"/></graph></graphml>`, "edges of both directions"},
+		{"json late directed", readJSON, `{"nodes": [{"id": 0}], "directed": true}`, "directed comes after"},
+		{"json node id", readJSON, `{"nodes": [{"id": [0]}]}`, "is not a string or a number"},
+		{"json truncated", readJSON, `{"nodes": [{"id": 0}`, "error: "},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.read(strings.NewReader(tc.src))
+			helpers.Assert(t, err != nil)
+			helpers.Assert(t, strings.Contains(err.Error(), tc.want))
+		})
+	}
+}
+
+func readEdgeList(r io.Reader) error {
+	_, err := formats.ReadEdgeList(r, graphs.Options{}, adjacencylist.New)
+	return err
+}
+
+func readDIMACS(r io.Reader) error {
+	_, _, err := formats.ReadDIMACS(r, adjacencylist.New)
+	return err
+}
+
+func readMatrixMarket(r io.Reader) error {
+	_, err := formats.ReadMatrixMarket(r, adjacencylist.New)
+	return err
+}
+
+func readGraphML(r io.Reader) error {
+	_, _, err := formats.ReadGraphML(r, graphs.Options{}, adjacencylist.New)
+	return err
+}
+
+func readJSON(r io.Reader) error {
+	_, _, err := formats.ReadJSON(r, graphs.Options{}, adjacencylist.New)
+	return err
+}
@@ -0,0 +1,167 @@
+package formats
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// ReadGraphML reads the graph in a GraphML document. Nodes get ids in the
+// order they first appear, in a node or an edge, and their GraphML ids are
+// returned in that order, so graphs.NewLabeled can label the graph with them.
+//
+// The weight of an edge is its data for the edge key named weight, or else
+// that key's default, or else 1. A repeated edge keeps the last weight. Other
+// data, ports and the directed attribute of edges that agree with the graph's
+// edgedefault are ignored, but documents with more than one graph, nested
+// graphs or hyperedges are not supported.
+//
+// The graph is built with newGraph, with o.TotalVertices vertices and the
+// direction of the document's edgedefault, and grows by a vertex for each
+// node past those. Growing a graph one vertex at a time is slow for some
+// graphs, such as adjacency matrices, so o.TotalVertices should be given
+// whenever the number of nodes is known. Vertices it counts beyond the nodes
+// read are removed at the end.
+func ReadGraphML[W graphs.Number](r io.Reader, o graphs.Options, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], []string, error) {
+	d := xml.NewDecoder(r)
+	errorf := func(format string, args ...any) error {
+		line, _ := d.InputPos()
+		return fmt.Errorf("error: line %v: %v", line, fmt.Sprintf(format, args...))
+	}
+
+	var g graphs.WeightedGraph[W]
+	weightKey, defaultWeight := "", W(1)
+	ids := map[string]int{}
+	names := []string{}
+	node := func(name string) int {
+		if v, ok := ids[name]; ok {
+			return v
+		}
+		ids[name] = len(names)
+		names = append(names, name)
+		if g.Size() < len(names) {
+			g.AddVertex()
+		}
+		return len(names) - 1
+	}
+
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error: %v", err)
+		}
+		start, ok := t.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "key":
+			var k struct {
+				ID      string `xml:"id,attr"`
+				For     string `xml:"for,attr"`
+				Name    string `xml:"attr.name,attr"`
+				Default string `xml:"default"`
+			}
+			if err := d.DecodeElement(&k, &start); err != nil {
+				return nil, nil, fmt.Errorf("error: %v", err)
+			}
+			if k.Name != "weight" || k.For != "edge" && k.For != "all" {
+				continue
+			}
+			weightKey = k.ID
+			if k.Default != "" {
+				if defaultWeight, err = parseWeight[W](strings.TrimSpace(k.Default)); err != nil {
+					return nil, nil, errorf("%v", err)
+				}
+			}
+		case "graph":
+			if g != nil {
+				return nil, nil, errorf("nested graphs and more than one graph are not supported")
+			}
+			undirected := false
+			for _, a := range start.Attr {
+				if a.Name.Local == "edgedefault" {
+					undirected = a.Value == "undirected"
+				}
+			}
+			g = newGraph(graphs.Options{TotalVertices: o.TotalVertices, Undirected: undirected})
+		case "node":
+			if g == nil {
+				return nil, nil, errorf("node outside a graph")
+			}
+			for _, a := range start.Attr {
+				if a.Name.Local == "id" {
+					node(a.Value)
+				}
+			}
+		case "edge":
+			if g == nil {
+				return nil, nil, errorf("edge outside a graph")
+			}
+			var e struct {
+				Source   string `xml:"source,attr"`
+				Target   string `xml:"target,attr"`
+				Directed string `xml:"directed,attr"`
+				Data     []struct {
+					Key   string `xml:"key,attr"`
+					Value string `xml:",chardata"`
+				} `xml:"data"`
+			}
+			if err := d.DecodeElement(&e, &start); err != nil {
+				return nil, nil, fmt.Errorf("error: %v", err)
+			}
+			if e.Directed != "" && (e.Directed == "true") == g.Undirected() {
+				return nil, nil, errorf("edges of both directions are not supported")
+			}
+			weight := defaultWeight
+			for _, data := range e.Data {
+				if data.Key == weightKey {
+					if weight, err = parseWeight[W](strings.TrimSpace(data.Value)); err != nil {
+						return nil, nil, errorf("%v", err)
+					}
+				}
+			}
+			g.UpdateEdge(node(e.Source), node(e.Target), weight)
+		case "hyperedge":
+			return nil, nil, errorf("hyperedges are not supported")
+		}
+	}
+	if g == nil {
+		return nil, nil, errorf("no graph")
+	}
+	trim(g, len(names))
+	return g, names, nil
+}
+
+// WriteGraphML writes g to w as a GraphML document, with the weights as data
+// for an edge key named weight. Vertex v is written as the node n followed by
+// v, as GraphML tools usually name nodes.
+func WriteGraphML[W graphs.Number](w io.Writer, g graphs.WeightedGraph[W]) error {
+	b := bufio.NewWriter(w)
+	kind, direction := "double", "directed"
+	if integral[W]() {
+		kind = "long"
+	}
+	if g.Undirected() {
+		direction = "undirected"
+	}
+	b.WriteString(xml.Header)
+	b.WriteString("<graphml xmlns=\"http://graphml.graphdrawing.org/xmlns\">\n")
+	fmt.Fprintf(b, "  <key id=\"weight\" for=\"edge\" attr.name=\"weight\" attr.type=\"%v\"/>\n", kind)
+	fmt.Fprintf(b, "  <graph id=\"G\" edgedefault=\"%v\">\n", direction)
+	for v := 0; v < g.Size(); v++ {
+		fmt.Fprintf(b, "    <node id=\"n%v\"/>\n", v)
+	}
+	for e := range edges(g) {
+		fmt.Fprintf(b, "    <edge source=\"n%v\" target=\"n%v\"><data key=\"weight\">%v</data></edge>\n", e.src, e.dst, e.weight)
+	}
+	b.WriteString("  </graph>\n</graphml>\n")
+	return b.Flush()
+}
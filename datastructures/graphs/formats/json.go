@@ -0,0 +1,190 @@
+package formats
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// ReadJSON reads a graph in the JSON node-link format of tools such as
+// NetworkX and D3: an object with whether the graph is directed, a list of
+// nodes, each an object with an id, and a list of links, each an object with
+// a source, a target and optionally a weight, which is otherwise 1. The links
+// can also be called edges. Nodes get ids in the order they first appear, in
+// a node or a link, and their JSON ids are returned in that order as text, so
+// graphs.NewLabeled can label the graph with them.
+//
+// Nodes and links are read one at a time. A graph is undirected unless
+// directed is true, which has to come before the nodes and links. Other
+// fields are ignored, and a repeated link keeps the last weight.
+//
+// The graph is built with newGraph, with o.TotalVertices vertices and the
+// direction the document gives, and grows by a vertex for each node past
+// those. As in ReadGraphML, o.TotalVertices should be given whenever the
+// number of nodes is known, and vertices it counts beyond the nodes read are
+// removed at the end.
+func ReadJSON[W graphs.Number](r io.Reader, o graphs.Options, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], []string, error) {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	errorf := func(format string, args ...any) error {
+		return fmt.Errorf("error: offset %v: %v", d.InputOffset(), fmt.Sprintf(format, args...))
+	}
+	delim := func(want json.Delim) error {
+		t, err := d.Token()
+		if err != nil {
+			return fmt.Errorf("error: %v", err)
+		}
+		if t != want {
+			return errorf("expected %v, found %v", want, t)
+		}
+		return nil
+	}
+
+	var g graphs.WeightedGraph[W]
+	undirected := true
+	ids := map[string]int{}
+	names := []string{}
+	start := func() {
+		if g == nil {
+			g = newGraph(graphs.Options{TotalVertices: o.TotalVertices, Undirected: undirected})
+		}
+	}
+	node := func(id any) (int, error) {
+		var name string
+		switch id := id.(type) {
+		case string:
+			name = id
+		case json.Number:
+			name = id.String()
+		default:
+			return 0, errorf("node id %v is not a string or a number", id)
+		}
+		if v, ok := ids[name]; ok {
+			return v, nil
+		}
+		ids[name] = len(names)
+		names = append(names, name)
+		if g.Size() < len(names) {
+			g.AddVertex()
+		}
+		return len(names) - 1, nil
+	}
+
+	if err := delim('{'); err != nil {
+		return nil, nil, err
+	}
+	for d.More() {
+		t, err := d.Token()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error: %v", err)
+		}
+		switch t {
+		case "directed":
+			var directed bool
+			if err := d.Decode(&directed); err != nil {
+				return nil, nil, fmt.Errorf("error: %v", err)
+			}
+			if g != nil && directed == g.Undirected() {
+				return nil, nil, errorf("directed comes after the nodes or links")
+			}
+			undirected = !directed
+		case "nodes":
+			start()
+			if err := delim('['); err != nil {
+				return nil, nil, err
+			}
+			for d.More() {
+				var n map[string]any
+				if err := d.Decode(&n); err != nil {
+					return nil, nil, fmt.Errorf("error: %v", err)
+				}
+				id, ok := n["id"]
+				if !ok {
+					return nil, nil, errorf("node has no id")
+				}
+				if _, err := node(id); err != nil {
+					return nil, nil, err
+				}
+			}
+			if err := delim(']'); err != nil {
+				return nil, nil, err
+			}
+		case "links", "edges":
+			start()
+			if err := delim('['); err != nil {
+				return nil, nil, err
+			}
+			for d.More() {
+				var l map[string]any
+				if err := d.Decode(&l); err != nil {
+					return nil, nil, fmt.Errorf("error: %v", err)
+				}
+				src, err := node(l["source"])
+				if err != nil {
+					return nil, nil, err
+				}
+				dst, err := node(l["target"])
+				if err != nil {
+					return nil, nil, err
+				}
+				weight := W(1)
+				if x, ok := l["weight"]; ok {
+					n, ok := x.(json.Number)
+					if !ok {
+						return nil, nil, errorf("weight %v is not a number", x)
+					}
+					if weight, err = parseWeight[W](n.String()); err != nil {
+						return nil, nil, errorf("%v", err)
+					}
+				}
+				g.UpdateEdge(src, dst, weight)
+			}
+			if err := delim(']'); err != nil {
+				return nil, nil, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := d.Decode(&skip); err != nil {
+				return nil, nil, fmt.Errorf("error: %v", err)
+			}
+		}
+	}
+	if err := delim('}'); err != nil {
+		return nil, nil, err
+	}
+	start()
+	trim(g, len(names))
+	return g, names, nil
+}
+
+// WriteJSON writes g to w in the JSON node-link format, with the ids of the
+// vertices as the ids of the nodes. Weights that are not finite cannot be
+// written in JSON.
+func WriteJSON[W graphs.Number](w io.Writer, g graphs.WeightedGraph[W]) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "{\n  \"directed\": %v,\n  \"multigraph\": false,\n  \"graph\": {},\n  \"nodes\": [", !g.Undirected())
+	for v := 0; v < g.Size(); v++ {
+		if v > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(b, "\n    {\"id\": %v}", v)
+	}
+	b.WriteString("\n  ],\n  \"links\": [")
+	first := true
+	for e := range edges(g) {
+		weight, err := json.Marshal(e.weight)
+		if err != nil {
+			return fmt.Errorf("error: weight of %v to %v: %v", e.src, e.dst, err)
+		}
+		if !first {
+			b.WriteString(",")
+		}
+		first = false
+		fmt.Fprintf(b, "\n    {\"source\": %v, \"target\": %v, \"weight\": %s}", e.src, e.dst, weight)
+	}
+	b.WriteString("\n  ]\n}\n")
+	return b.Flush()
+}
@@ -0,0 +1,130 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// ReadMatrixMarket reads a graph from the adjacency matrix in a Matrix Market
+// coordinate file, in which each line after the header is the row and column
+// of an entry, counted from 1, and its value unless the matrix is a pattern.
+// Entries of a pattern weigh 1. Symmetric matrices, which only store the
+// entries on one side of the diagonal, give undirected graphs.
+//
+// Only square real, integer and pattern matrices that are general or
+// symmetric are graphs here.
+func ReadMatrixMarket[W graphs.Number](r io.Reader, newGraph func(graphs.Options) graphs.WeightedGraph[W]) (graphs.WeightedGraph[W], error) {
+	l := newLines(r, "%")
+	if !l.s.Scan() {
+		if err := l.err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error: file is empty")
+	}
+	l.line++
+	banner := strings.Fields(strings.ToLower(l.s.Text()))
+	if len(banner) != 5 || banner[0] != "%%matrixmarket" || banner[1] != "matrix" {
+		return nil, l.errorf("expected a %%%%MatrixMarket matrix header")
+	}
+	format, field, symmetry := banner[2], banner[3], banner[4]
+	if format != "coordinate" {
+		return nil, l.errorf("%v matrices are not supported", format)
+	}
+	if field != "real" && field != "double" && field != "integer" && field != "pattern" {
+		return nil, l.errorf("%v matrices are not supported", field)
+	}
+	if symmetry != "general" && symmetry != "symmetric" {
+		return nil, l.errorf("%v matrices are not supported", symmetry)
+	}
+
+	fields, ok := l.next()
+	if !ok {
+		if err := l.err(); err != nil {
+			return nil, err
+		}
+		return nil, l.errorf("expected the size of the matrix")
+	}
+	size := make([]int, len(fields))
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return nil, l.errorf("expected the size of the matrix, found %q", f)
+		}
+		size[i] = n
+	}
+	if len(size) != 3 {
+		return nil, l.errorf("expected the rows, columns and entries of the matrix")
+	}
+	if size[0] != size[1] {
+		return nil, l.errorf("a %v by %v matrix is not the adjacency matrix of a graph", size[0], size[1])
+	}
+	n, entries := size[0], size[2]
+
+	g := newGraph(graphs.Options{TotalVertices: uint32(n), Undirected: symmetry == "symmetric"})
+	read := 0
+	for {
+		fields, ok := l.next()
+		if !ok {
+			break
+		}
+		want := 3
+		if field == "pattern" {
+			want = 2
+		}
+		if len(fields) != want {
+			return nil, l.errorf("expected %v fields in an entry, found %v", want, len(fields))
+		}
+		src, err := parseVertex(fields[0], 1, n)
+		if err != nil {
+			return nil, l.errorf("%v", err)
+		}
+		dst, err := parseVertex(fields[1], 1, n)
+		if err != nil {
+			return nil, l.errorf("%v", err)
+		}
+		weight := W(1)
+		if field != "pattern" {
+			if weight, err = parseWeight[W](fields[2]); err != nil {
+				return nil, l.errorf("%v", err)
+			}
+		}
+		g.UpdateEdge(src, dst, weight)
+		read++
+	}
+	if err := l.err(); err != nil {
+		return nil, err
+	}
+	if read != entries {
+		return nil, l.errorf("the matrix has %v entries but %v were read", entries, read)
+	}
+	return g, nil
+}
+
+// WriteMatrixMarket writes the adjacency matrix of g to w as a Matrix Market
+// coordinate file. Integer weights make an integer matrix and floating point
+// weights a real one. An undirected graph is written as a symmetric matrix of
+// the entries on and below the diagonal.
+func WriteMatrixMarket[W graphs.Number](w io.Writer, g graphs.WeightedGraph[W]) error {
+	b := bufio.NewWriter(w)
+	field, symmetry := "real", "general"
+	if integral[W]() {
+		field = "integer"
+	}
+	if g.Undirected() {
+		symmetry = "symmetric"
+	}
+	fmt.Fprintf(b, "%%%%MatrixMarket matrix coordinate %v %v\n", field, symmetry)
+	fmt.Fprintf(b, "%v %v %v\n", g.Size(), g.Size(), count(edges(g)))
+	for e := range edges(g) {
+		if g.Undirected() {
+			e.src, e.dst = e.dst, e.src
+		}
+		fmt.Fprintf(b, "%v %v %v\n", e.src+1, e.dst+1, e.weight)
+	}
+	return b.Flush()
+}
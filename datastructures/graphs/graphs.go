@@ -2,20 +2,39 @@ package graphs
 
 import (
 	"github.com/mhrdini/godsa/datastructures/containers"
+	"golang.org/x/exp/constraints"
 )
 
-type Graph interface {
+// Number is the set of types edge weights can have.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Topology is the part of a graph that does not depend on its edge weights,
+// which every WeightedGraph has whatever its weight type.
+type Topology interface {
 	containers.Container[int]
 	Adjacent(v1, v2 int) bool
 	Neighbors(v int) []int
-	Transpose() Graph
+	Undirected() bool
+}
+
+// WeightedGraph is a graph on the vertices 0..n-1 whose edges have weights of
+// type W.
+type WeightedGraph[W Number] interface {
+	Topology
+	Transpose() WeightedGraph[W]
+	Weight(src, dst int) (weight W, ok bool)
 	AddVertex()
 	RemoveVertex(v int) bool
-	AddEdge(src, dst, weight int) (ok bool)
-	UpdateEdge(src, dst, weight int) (inserted bool)
+	AddEdge(src, dst int, weight W) (ok bool)
+	UpdateEdge(src, dst int, weight W) (inserted bool)
 	RemoveEdge(src, dst int) (ok bool)
 }
 
+// Graph is a graph with integer edge weights.
+type Graph = WeightedGraph[int]
+
 type Options struct {
 	TotalVertices uint32
 	Undirected    bool
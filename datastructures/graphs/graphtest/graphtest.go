@@ -0,0 +1,41 @@
+// Package graphtest builds the small graphs that tests of graph algorithms
+// run on, from a list of their edges.
+package graphtest
+
+import (
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+)
+
+// Edge is the source, destination and weight of an edge.
+type Edge [3]int
+
+// Unweighted returns the edges between the given pairs of vertices, each of
+// weight 1.
+func Unweighted(pairs [][2]int) []Edge {
+	edges := []Edge{}
+	for _, p := range pairs {
+		edges = append(edges, Edge{p[0], p[1], 1})
+	}
+	return edges
+}
+
+// Build returns an adjacency list of n vertices with the given edges.
+func Build(n int, undirected bool, edges []Edge) graphs.Graph {
+	return add(adjacencylist.New(graphs.Options{TotalVertices: uint32(n), Undirected: undirected}), edges)
+}
+
+// BuildEach returns the graph of Build both as an adjacency list and as an
+// adjacency matrix, for tests that should pass on either.
+func BuildEach(n int, undirected bool, edges []Edge) []graphs.Graph {
+	o := graphs.Options{TotalVertices: uint32(n), Undirected: undirected}
+	return []graphs.Graph{add(adjacencylist.New(o), edges), add(adjacencymatrix.New(o), edges)}
+}
+
+func add(g graphs.Graph, edges []Edge) graphs.Graph {
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1], e[2])
+	}
+	return g
+}
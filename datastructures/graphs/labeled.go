@@ -0,0 +1,183 @@
+package graphs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WeightedLabeledGraph identifies the vertices of a WeightedGraph by keys
+// instead of by the ints 0..n-1 the graph uses, which are called ids here.
+// The algorithms/graphs/labeled package runs the algorithms on it with keys in
+// and keys out. Other algorithms take the underlying graph, so pass ids in with
+// ID and turn their results back into keys with Key, KeysOf and ByKey.
+type WeightedLabeledGraph[K comparable, W Number] struct {
+	g    WeightedGraph[W]
+	ids  map[K]int
+	keys []K
+}
+
+// LabeledGraph is a labelled graph with integer edge weights.
+type LabeledGraph[K comparable] = WeightedLabeledGraph[K, int]
+
+// NewLabeled wraps g, labelling its existing vertices in order with keys,
+// which must be distinct and one per vertex.
+func NewLabeled[K comparable, W Number](g WeightedGraph[W], keys ...K) (*WeightedLabeledGraph[K, W], error) {
+	if len(keys) != g.Size() {
+		return nil, fmt.Errorf("error: %v keys given for %v vertices", len(keys), g.Size())
+	}
+	l := &WeightedLabeledGraph[K, W]{g, map[K]int{}, []K{}}
+	for _, k := range keys {
+		if _, ok := l.ids[k]; ok {
+			return nil, fmt.Errorf("error: key %v is given twice", k)
+		}
+		l.ids[k] = len(l.keys)
+		l.keys = append(l.keys, k)
+	}
+	return l, nil
+}
+
+// Graph returns the underlying graph, which algorithms can run on directly.
+// Changing it bypasses the labels.
+func (l *WeightedLabeledGraph[K, W]) Graph() WeightedGraph[W] {
+	return l.g
+}
+
+func (l *WeightedLabeledGraph[K, W]) Size() int {
+	return len(l.keys)
+}
+
+func (l *WeightedLabeledGraph[K, W]) Undirected() bool {
+	return l.g.Undirected()
+}
+
+func (l *WeightedLabeledGraph[K, W]) String() string {
+	b := strings.Builder{}
+	for id, k := range l.keys {
+		fmt.Fprintf(&b, "%v: %v\n", k, l.KeysOf(l.g.Neighbors(id)))
+	}
+	return b.String()
+}
+
+// ID returns the id of the vertex labelled k.
+func (l *WeightedLabeledGraph[K, W]) ID(k K) (int, bool) {
+	id, ok := l.ids[k]
+	return id, ok
+}
+
+// IDs returns the ids of the vertices labelled ks, failing if any is missing.
+func (l *WeightedLabeledGraph[K, W]) IDs(ks ...K) ([]int, bool) {
+	ids := make([]int, len(ks))
+	for i, k := range ks {
+		id, ok := l.ids[k]
+		if !ok {
+			return nil, false
+		}
+		ids[i] = id
+	}
+	return ids, true
+}
+
+// Key returns the label of the vertex with the given id.
+func (l *WeightedLabeledGraph[K, W]) Key(id int) (K, bool) {
+	if id < 0 || id >= len(l.keys) {
+		var zero K
+		return zero, false
+	}
+	return l.keys[id], true
+}
+
+// Keys returns every label, ordered by id.
+func (l *WeightedLabeledGraph[K, W]) Keys() []K {
+	return append([]K{}, l.keys...)
+}
+
+// KeysOf returns the labels of the vertices with the given ids.
+func (l *WeightedLabeledGraph[K, W]) KeysOf(ids []int) []K {
+	ks := make([]K, len(ids))
+	for i, id := range ids {
+		ks[i] = l.keys[id]
+	}
+	return ks
+}
+
+// ByKey turns a result with one value per id, such as the scores of a
+// centrality measure, into a map from labels. The vertices returned by a
+// search refer to their parents by id, so labeled.Vertices turns those into
+// keys instead.
+func ByKey[K comparable, W Number, V any](l *WeightedLabeledGraph[K, W], values []V) map[K]V {
+	m := make(map[K]V, len(values))
+	for id, v := range values {
+		m[l.keys[id]] = v
+	}
+	return m
+}
+
+// AddVertex adds a vertex labelled k and returns its id. If there already is
+// one it returns its id and false.
+func (l *WeightedLabeledGraph[K, W]) AddVertex(k K) (id int, added bool) {
+	if id, ok := l.ids[k]; ok {
+		return id, false
+	}
+	l.g.AddVertex()
+	id = len(l.keys)
+	l.ids[k] = id
+	l.keys = append(l.keys, k)
+	return id, true
+}
+
+// RemoveVertex removes the vertex labelled k. Like Graph.RemoveVertex, it
+// moves every vertex with a higher id down by one; their labels move along.
+func (l *WeightedLabeledGraph[K, W]) RemoveVertex(k K) bool {
+	id, ok := l.ids[k]
+	if !ok || !l.g.RemoveVertex(id) {
+		return false
+	}
+	delete(l.ids, k)
+	l.keys = append(l.keys[:id], l.keys[id+1:]...)
+	for i := id; i < len(l.keys); i++ {
+		l.ids[l.keys[i]] = i
+	}
+	return true
+}
+
+func (l *WeightedLabeledGraph[K, W]) Adjacent(k1, k2 K) bool {
+	ids, ok := l.IDs(k1, k2)
+	return ok && l.g.Adjacent(ids[0], ids[1])
+}
+
+func (l *WeightedLabeledGraph[K, W]) Neighbors(k K) []K {
+	id, ok := l.ids[k]
+	if !ok {
+		return nil
+	}
+	return l.KeysOf(l.g.Neighbors(id))
+}
+
+func (l *WeightedLabeledGraph[K, W]) Weight(src, dst K) (W, bool) {
+	ids, ok := l.IDs(src, dst)
+	if !ok {
+		return 0, false
+	}
+	return l.g.Weight(ids[0], ids[1])
+}
+
+// AddEdge adds an edge from src to dst, adding either vertex if it is
+// missing.
+func (l *WeightedLabeledGraph[K, W]) AddEdge(src, dst K, weight W) bool {
+	s, _ := l.AddVertex(src)
+	d, _ := l.AddVertex(dst)
+	return l.g.AddEdge(s, d, weight)
+}
+
+// UpdateEdge sets the weight of the edge from src to dst, adding the edge and
+// either vertex if they are missing.
+func (l *WeightedLabeledGraph[K, W]) UpdateEdge(src, dst K, weight W) bool {
+	s, _ := l.AddVertex(src)
+	d, _ := l.AddVertex(dst)
+	return l.g.UpdateEdge(s, d, weight)
+}
+
+func (l *WeightedLabeledGraph[K, W]) RemoveEdge(src, dst K) bool {
+	ids, ok := l.IDs(src, dst)
+	return ok && l.g.RemoveEdge(ids[0], ids[1])
+}
@@ -0,0 +1,125 @@
+package graphs_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func services(t *testing.T, g graphs.Graph) *graphs.LabeledGraph[string] {
+	t.Helper()
+	l, err := graphs.NewLabeled[string](g)
+	helpers.AssertEqual(t, err, nil)
+	l.AddEdge("web", "auth", 2)
+	l.AddEdge("web", "api", 1)
+	l.AddEdge("api", "auth", 4)
+	l.AddEdge("api", "db", 3)
+	l.AddEdge("auth", "db", 1)
+	l.AddEdge("db", "api", 5)
+	return l
+}
+
+func TestLabeledGraph(t *testing.T) {
+	o := graphs.Options{}
+	for _, g := range []graphs.Graph{adjacencylist.New(o), adjacencymatrix.New(o)} {
+		t.Run(g.Name(), func(t *testing.T) {
+			l := services(t, g)
+			helpers.AssertEqual(t, l.Size(), 4)
+			helpers.AssertEqual(t, helpers.ToString(l.Keys()), "[web auth api db]")
+			helpers.AssertEqual(t, helpers.ToString(l.Neighbors("api")), "[auth db]")
+			helpers.Assert(t, l.Adjacent("auth", "db"))
+			helpers.Assert(t, !l.Adjacent("db", "auth"))
+			helpers.Assert(t, !l.Adjacent("db", "cache"))
+
+			w, ok := l.Weight("api", "db")
+			helpers.Assert(t, ok)
+			helpers.AssertEqual(t, w, 3)
+
+			id, added := l.AddVertex("api")
+			helpers.Assert(t, !added)
+			helpers.AssertEqual(t, id, 2)
+			id, added = l.AddVertex("cache")
+			helpers.Assert(t, added)
+			helpers.AssertEqual(t, id, 4)
+			helpers.AssertEqual(t, l.Graph().Size(), 5)
+
+			helpers.Assert(t, l.RemoveEdge("web", "api"))
+			helpers.Assert(t, !l.RemoveEdge("web", "cache"))
+			helpers.AssertEqual(t, helpers.ToString(l.Neighbors("web")), "[auth]")
+		})
+	}
+}
+
+func TestLabeledGraphAlgorithms(t *testing.T) {
+	l := services(t, adjacencylist.New(graphs.Options{}))
+
+	t.Run("shortest paths", func(t *testing.T) {
+		src, _ := l.ID("web")
+		vertices := dijkstra.Run(l.Graph(), src)
+		helpers.AssertEqual(t, graphs.ByKey(l, vertices)["db"].Dist, 3.0)
+
+		dst, _ := l.ID("db")
+		path, ok := dijkstra.Path(vertices, dst)
+		helpers.Assert(t, ok)
+		helpers.AssertEqual(t, helpers.ToString(l.KeysOf(path)), "[web auth db]")
+	})
+
+	t.Run("strongly connected components", func(t *testing.T) {
+		components := [][]string{}
+		for _, c := range scc.Run(l.Graph()) {
+			ks := l.KeysOf(c)
+			slices.Sort(ks)
+			components = append(components, ks)
+		}
+		helpers.AssertEqual(t, helpers.ToString(components), "[[web] [api auth db]]")
+	})
+}
+
+func TestNewLabeled(t *testing.T) {
+	g := adjacencymatrix.New(graphs.Options{TotalVertices: 3})
+	g.AddEdge(0, 2, 1)
+
+	_, err := graphs.NewLabeled(g, "a", "b")
+	helpers.Assert(t, err != nil)
+	_, err = graphs.NewLabeled(g, "a", "b", "a")
+	helpers.Assert(t, err != nil)
+
+	l, err := graphs.NewLabeled(g, "a", "b", "c")
+	helpers.AssertEqual(t, err, nil)
+	helpers.AssertEqual(t, helpers.ToString(l.Neighbors("a")), "[c]")
+
+	helpers.Assert(t, l.RemoveVertex("b"))
+	helpers.Assert(t, !l.RemoveVertex("b"))
+	id, _ := l.ID("c")
+	helpers.AssertEqual(t, id, 1)
+	helpers.AssertEqual(t, helpers.ToString(l.Neighbors("a")), "[c]")
+	k, ok := l.Key(1)
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, k, "c")
+	_, ok = l.Key(2)
+	helpers.Assert(t, !ok)
+}
+
+func TestAddVertex(t *testing.T) {
+	o := graphs.Options{TotalVertices: 3}
+	for _, g := range []graphs.Graph{adjacencylist.New(o), adjacencymatrix.New(o)} {
+		t.Run(g.Name(), func(t *testing.T) {
+			g.AddEdge(0, 1, 1)
+			g.AddEdge(1, 2, 1)
+			g.AddEdge(2, 2, 1)
+			g.AddVertex()
+			helpers.AssertEqual(t, g.Size(), 4)
+			for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 2}} {
+				helpers.Assert(t, g.Adjacent(e[0], e[1]))
+			}
+			helpers.AssertEqual(t, len(g.Neighbors(3)), 0)
+			helpers.Assert(t, g.AddEdge(3, 0, 1))
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package multigraph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+const multiGraph = "MultiGraph"
+
+// Graph allows any number of edges between two vertices, including
+// self-loops. Every edge gets an id when it is added that stays the same until
+// it is removed, even when vertices are, and ids are never reused.
+//
+// Through the graphs.WeightedGraph methods, which name edges by their
+// endpoints, a Graph looks like a simple graph: Neighbors lists every
+// neighbour once, Weight is the weight of the lightest edge between two
+// vertices, UpdateEdge changes the oldest one and RemoveEdge removes them all.
+// AddEdge always adds a new edge. The methods that take ids reach every edge.
+type Graph[W graphs.Number] struct {
+	edges      map[int]*Edge[W]
+	out        [][]int // ids of the edges out of each vertex, oldest first
+	in         [][]int // ids of the edges into each vertex, if directed
+	next       int     // id of the next edge
+	undirected bool
+}
+
+// Edge is an edge from Src to Dst. An undirected edge keeps the endpoints in
+// the order it was added with.
+type Edge[W graphs.Number] struct {
+	ID, Src, Dst int
+	Weight       W
+}
+
+func New(o graphs.Options) graphs.Graph {
+	return NewWeighted[int](o)
+}
+
+// NewWeighted returns a graph whose edge weights have type W.
+func NewWeighted[W graphs.Number](o graphs.Options) graphs.WeightedGraph[W] {
+	n := int(o.TotalVertices)
+	g := &Graph[W]{
+		edges:      map[int]*Edge[W]{},
+		out:        make([][]int, n),
+		undirected: o.Undirected,
+	}
+	if !g.undirected {
+		g.in = make([][]int, n)
+	}
+	return g
+}
+
+func (e Edge[W]) String() string {
+	return fmt.Sprintf("#%v(%v --%v-> %v)", e.ID, e.Src, e.Weight, e.Dst)
+}
+
+func (g *Graph[W]) Name() string {
+	return multiGraph
+}
+
+func (g *Graph[W]) Size() int {
+	return len(g.out)
+}
+
+func (g *Graph[W]) Empty() bool {
+	return len(g.edges) == 0
+}
+
+func (g *Graph[W]) Values() []int {
+	vs := []int{}
+	for i := 0; i < g.Size(); i++ {
+		vs = append(vs, i)
+	}
+	return vs
+}
+
+func (g *Graph[W]) String() string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "total edges: %v, total vertices: %v, undirected: %v\n", len(g.edges), g.Size(), g.undirected)
+	for v, ids := range g.out {
+		fmt.Fprintf(&b, "%v: %v\n", v, g.lookup(ids))
+	}
+	return b.String()
+}
+
+func (g *Graph[W]) Reset() {
+	clear(g.edges)
+	for v := range g.out {
+		g.out[v] = nil
+		if !g.undirected {
+			g.in[v] = nil
+		}
+	}
+}
+
+// TotalEdges returns the number of edges, counting parallel ones.
+func (g *Graph[W]) TotalEdges() int {
+	return len(g.edges)
+}
+
+func (g *Graph[W]) Adjacent(v1, v2 int) bool {
+	return len(g.Between(v1, v2)) > 0
+}
+
+// Neighbors returns every vertex v has an edge to once, in the order of the
+// oldest edge to each.
+func (g *Graph[W]) Neighbors(v int) []int {
+	vs := []int{}
+	for _, id := range g.out[v] {
+		if u := g.other(id, v); !slices.Contains(vs, u) {
+			vs = append(vs, u)
+		}
+	}
+	return vs
+}
+
+// Transpose returns a new graph with every edge reversed. The edges keep
+// their ids.
+func (g *Graph[W]) Transpose() graphs.WeightedGraph[W] {
+	if g.undirected {
+		return g
+	}
+	t := &Graph[W]{
+		edges:      make(map[int]*Edge[W], len(g.edges)),
+		out:        make([][]int, len(g.out)),
+		in:         make([][]int, len(g.in)),
+		next:       g.next,
+		undirected: g.undirected,
+	}
+	for id, e := range g.edges {
+		t.edges[id] = &Edge[W]{id, e.Dst, e.Src, e.Weight}
+	}
+	for v := range g.out {
+		t.out[v] = slices.Clone(g.in[v])
+		t.in[v] = slices.Clone(g.out[v])
+	}
+	return t
+}
+
+// Weight returns the weight of the lightest edge from src to dst.
+func (g *Graph[W]) Weight(src, dst int) (W, bool) {
+	ids := g.Between(src, dst)
+	if len(ids) == 0 {
+		return 0, false
+	}
+	w := g.edges[ids[0]].Weight
+	for _, id := range ids[1:] {
+		w = min(w, g.edges[id].Weight)
+	}
+	return w, true
+}
+
+func (g *Graph[W]) Undirected() bool {
+	return g.undirected
+}
+
+func (g *Graph[W]) AddVertex() {
+	g.out = append(g.out, nil)
+	if !g.undirected {
+		g.in = append(g.in, nil)
+	}
+}
+
+// RemoveVertex removes v and its edges, and moves every vertex with a higher
+// id down by one. The ids of the other edges do not change.
+func (g *Graph[W]) RemoveVertex(v int) bool {
+	if !g.withinRange(v) {
+		return false
+	}
+	for _, id := range g.Incident(v) {
+		g.RemoveEdgeID(id)
+	}
+	g.out = slices.Delete(g.out, v, v+1)
+	if !g.undirected {
+		g.in = slices.Delete(g.in, v, v+1)
+	}
+	for _, e := range g.edges {
+		if e.Src > v {
+			e.Src--
+		}
+		if e.Dst > v {
+			e.Dst--
+		}
+	}
+	return true
+}
+
+// AddEdge adds a new edge from src to dst, even if there already is one.
+func (g *Graph[W]) AddEdge(src, dst int, weight W) bool {
+	return g.NewEdge(src, dst, weight) >= 0
+}
+
+// UpdateEdge sets the weight of the oldest edge from src to dst, adding an
+// edge if there is none.
+func (g *Graph[W]) UpdateEdge(src, dst int, weight W) bool {
+	if ids := g.Between(src, dst); len(ids) > 0 {
+		g.edges[ids[0]].Weight = weight
+		return false
+	}
+	return g.AddEdge(src, dst, weight)
+}
+
+// RemoveEdge removes every edge from src to dst.
+func (g *Graph[W]) RemoveEdge(src, dst int) bool {
+	ids := g.Between(src, dst)
+	for _, id := range ids {
+		g.RemoveEdgeID(id)
+	}
+	return len(ids) > 0
+}
+
+// NewEdge adds an edge from src to dst and returns its id, or -1 if either
+// vertex does not exist.
+func (g *Graph[W]) NewEdge(src, dst int, weight W) int {
+	if !g.withinRange(src) || !g.withinRange(dst) {
+		return -1
+	}
+	id := g.next
+	g.next++
+	g.edges[id] = &Edge[W]{id, src, dst, weight}
+	g.out[src] = append(g.out[src], id)
+	if g.undirected && src != dst {
+		g.out[dst] = append(g.out[dst], id)
+	} else if !g.undirected {
+		g.in[dst] = append(g.in[dst], id)
+	}
+	return id
+}
+
+// Edge returns the edge with the given id.
+func (g *Graph[W]) Edge(id int) (Edge[W], bool) {
+	e, ok := g.edges[id]
+	if !ok {
+		return Edge[W]{}, false
+	}
+	return *e, true
+}
+
+// Edges returns every edge, ordered by id.
+func (g *Graph[W]) Edges() []Edge[W] {
+	ids := make([]int, 0, len(g.edges))
+	for id := range g.edges {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return g.lookup(ids)
+}
+
+// EdgeWeight returns the weight of the edge with the given id.
+func (g *Graph[W]) EdgeWeight(id int) (W, bool) {
+	e, ok := g.edges[id]
+	if !ok {
+		return 0, false
+	}
+	return e.Weight, true
+}
+
+// SetEdgeWeight changes the weight of the edge with the given id.
+func (g *Graph[W]) SetEdgeWeight(id int, weight W) bool {
+	e, ok := g.edges[id]
+	if ok {
+		e.Weight = weight
+	}
+	return ok
+}
+
+// RemoveEdgeID removes the edge with the given id.
+func (g *Graph[W]) RemoveEdgeID(id int) bool {
+	e, ok := g.edges[id]
+	if !ok {
+		return false
+	}
+	delete(g.edges, id)
+	g.out[e.Src] = remove(g.out[e.Src], id)
+	if g.undirected {
+		g.out[e.Dst] = remove(g.out[e.Dst], id)
+	} else {
+		g.in[e.Dst] = remove(g.in[e.Dst], id)
+	}
+	return true
+}
+
+// Between returns the ids of the edges from src to dst, oldest first.
+func (g *Graph[W]) Between(src, dst int) []int {
+	ids := []int{}
+	if !g.withinRange(src) || !g.withinRange(dst) {
+		return ids
+	}
+	for _, id := range g.out[src] {
+		if g.other(id, src) == dst {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Incident returns the ids of the edges into or out of v, ordered by id.
+func (g *Graph[W]) Incident(v int) []int {
+	ids := slices.Clone(g.out[v])
+	if !g.undirected {
+		for _, id := range g.in[v] {
+			if g.edges[id].Src != v {
+				ids = append(ids, id)
+			}
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// other returns the endpoint of edge id that is not v, which for an edge out
+// of v is where it leads.
+func (g *Graph[W]) other(id, v int) int {
+	e := g.edges[id]
+	if e.Src == v {
+		return e.Dst
+	}
+	return e.Src
+}
+
+func (g *Graph[W]) lookup(ids []int) []Edge[W] {
+	es := make([]Edge[W], len(ids))
+	for i, id := range ids {
+		es[i] = *g.edges[id]
+	}
+	return es
+}
+
+func (g *Graph[W]) withinRange(v int) bool {
+	return v >= 0 && v < len(g.out)
+}
+
+func remove(ids []int, id int) []int {
+	if i := slices.Index(ids, id); i >= 0 {
+		return slices.Delete(ids, i, i+1)
+	}
+	return ids
+}
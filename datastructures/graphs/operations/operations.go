@@ -0,0 +1,86 @@
+package operations
+
+import "github.com/mhrdini/godsa/datastructures/graphs"
+
+// Each operation builds a new graph with newGraph, for example
+// adjacencylist.New or adjacencymatrix.NewWeighted[float64], and leaves its
+// inputs alone. Vertex i of one input is vertex i of the other. The result is
+// undirected only if every input is; an undirected edge of one input becomes
+// an edge in each direction otherwise.
+
+// Union returns the graph on the vertices of the larger of g and h with the
+// edges of both. Where both have an edge, it keeps the weight from g.
+func Union[W graphs.Number](g, h graphs.WeightedGraph[W], newGraph func(graphs.Options) graphs.WeightedGraph[W]) graphs.WeightedGraph[W] {
+	result := newGraph(options(max(g.Size(), h.Size()), g, h))
+	for _, x := range []graphs.WeightedGraph[W]{h, g} {
+		for u := 0; u < x.Size(); u++ {
+			for _, v := range x.Neighbors(u) {
+				w, _ := x.Weight(u, v)
+				result.UpdateEdge(u, v, w)
+			}
+		}
+	}
+	return result
+}
+
+// Intersection returns the graph on the vertices of the smaller of g and h
+// with the edges that both have, weighted as in g.
+func Intersection[W graphs.Number](g, h graphs.WeightedGraph[W], newGraph func(graphs.Options) graphs.WeightedGraph[W]) graphs.WeightedGraph[W] {
+	n := min(g.Size(), h.Size())
+	result := newGraph(options(n, g, h))
+	for u := 0; u < n; u++ {
+		for _, v := range g.Neighbors(u) {
+			if v < n && h.Adjacent(u, v) {
+				w, _ := g.Weight(u, v)
+				result.UpdateEdge(u, v, w)
+			}
+		}
+	}
+	return result
+}
+
+// Complement returns the graph on the vertices of g with an edge of weight 1
+// between two distinct vertices exactly when g has none. It has no
+// self-loops.
+func Complement[W graphs.Number](g graphs.WeightedGraph[W], newGraph func(graphs.Options) graphs.WeightedGraph[W]) graphs.WeightedGraph[W] {
+	result := newGraph(options(g.Size(), g))
+	for u := 0; u < g.Size(); u++ {
+		for v := 0; v < g.Size(); v++ {
+			if u != v && !g.Adjacent(u, v) {
+				result.UpdateEdge(u, v, 1)
+			}
+		}
+	}
+	return result
+}
+
+// Product returns the Cartesian product of g and h, whose vertex
+// a*h.Size() + b stands for the pair of a in g and b in h. There is an edge
+// from (a, b) to (c, b) for every edge from a to c in g, and from (a, b) to
+// (a, d) for every edge from b to d in h, with the weight of that edge. The
+// product of two paths is a grid.
+func Product[W graphs.Number](g, h graphs.WeightedGraph[W], newGraph func(graphs.Options) graphs.WeightedGraph[W]) graphs.WeightedGraph[W] {
+	m := h.Size()
+	result := newGraph(options(g.Size()*m, g, h))
+	for a := 0; a < g.Size(); a++ {
+		for b := 0; b < m; b++ {
+			for _, c := range g.Neighbors(a) {
+				w, _ := g.Weight(a, c)
+				result.UpdateEdge(a*m+b, c*m+b, w)
+			}
+			for _, d := range h.Neighbors(b) {
+				w, _ := h.Weight(b, d)
+				result.UpdateEdge(a*m+b, a*m+d, w)
+			}
+		}
+	}
+	return result
+}
+
+func options(n int, gs ...graphs.Topology) graphs.Options {
+	undirected := true
+	for _, g := range gs {
+		undirected = undirected && g.Undirected()
+	}
+	return graphs.Options{TotalVertices: uint32(n), Undirected: undirected}
+}
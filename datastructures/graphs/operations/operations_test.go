@@ -0,0 +1,69 @@
+package operations_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/graphtest"
+	"github.com/mhrdini/godsa/datastructures/graphs/operations"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// edges lists the edges of g with their weights.
+func edges(g graphs.Graph) string {
+	es := []graphtest.Edge{}
+	for u := 0; u < g.Size(); u++ {
+		for v := 0; v < g.Size(); v++ {
+			if w, ok := g.Weight(u, v); ok && (!g.Undirected() || u <= v) {
+				es = append(es, graphtest.Edge{u, v, w})
+			}
+		}
+	}
+	return fmt.Sprint(es)
+}
+
+func TestUnionAndIntersection(t *testing.T) {
+	g := graphtest.Build(3, false, []graphtest.Edge{{0, 1, 1}, {1, 2, 2}})
+	h := graphtest.Build(4, false, []graphtest.Edge{{0, 1, 5}, {2, 3, 3}, {2, 0, 4}})
+
+	u := operations.Union(g, h, adjacencylist.New)
+	helpers.AssertEqual(t, u.Size(), 4)
+	helpers.AssertEqual(t, edges(u), "[[0 1 1] [1 2 2] [2 0 4] [2 3 3]]")
+
+	i := operations.Intersection(g, h, adjacencymatrix.New)
+	helpers.AssertEqual(t, i.Name(), "AdjacencyMatrix")
+	helpers.AssertEqual(t, i.Size(), 3)
+	helpers.AssertEqual(t, edges(i), "[[0 1 1]]")
+
+	t.Run("mixed directions", func(t *testing.T) {
+		path := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}})
+		back := graphtest.Build(3, false, []graphtest.Edge{{2, 1, 6}})
+		u := operations.Union(path, back, adjacencylist.New)
+		helpers.Assert(t, !u.Undirected())
+		helpers.AssertEqual(t, edges(u), "[[0 1 1] [1 0 1] [1 2 1] [2 1 1]]")
+		i := operations.Intersection(back, path, adjacencylist.New)
+		helpers.AssertEqual(t, edges(i), "[[2 1 6]]")
+	})
+}
+
+func TestComplement(t *testing.T) {
+	g := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 4}, {2, 2, 1}})
+	c := operations.Complement(g, adjacencylist.New)
+	helpers.Assert(t, c.Undirected())
+	helpers.AssertEqual(t, edges(c), "[[0 2 1] [1 2 1]]")
+
+	d := graphtest.Build(3, false, []graphtest.Edge{{0, 1, 4}})
+	helpers.AssertEqual(t, edges(operations.Complement(d, adjacencylist.New)), "[[0 2 1] [1 0 1] [1 2 1] [2 0 1] [2 1 1]]")
+}
+
+func TestProduct(t *testing.T) {
+	// the product of a path of 3 and a path of 2 is a 3 by 2 grid
+	p3 := graphtest.Build(3, true, []graphtest.Edge{{0, 1, 1}, {1, 2, 1}})
+	p2 := graphtest.Build(2, true, []graphtest.Edge{{0, 1, 2}})
+	grid := operations.Product(p3, p2, adjacencylist.New)
+	helpers.AssertEqual(t, grid.Size(), 6)
+	helpers.AssertEqual(t, edges(grid), "[[0 1 2] [0 2 1] [1 3 1] [2 3 2] [2 4 1] [3 5 1] [4 5 2]]")
+}
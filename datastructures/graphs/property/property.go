@@ -0,0 +1,221 @@
+package property
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/views"
+)
+
+// Key names an attribute whose values have type T. Declaring keys once, as
+// in
+//
+//	var Owner = property.NewKey[string]("owner")
+//
+// lets Get and Set check the types of attributes at compile time.
+type Key[T any] struct {
+	name string
+}
+
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{name}
+}
+
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+// Attributes holds the attributes of one vertex or edge by name.
+type Attributes map[string]any
+
+// Get returns the value of k, or false if it is not set or was set with
+// another type under the same name.
+func Get[T any](a Attributes, k Key[T]) (T, bool) {
+	x, ok := a[k.name].(T)
+	return x, ok
+}
+
+// Set sets the value of k.
+func Set[T any](a Attributes, k Key[T], x T) {
+	a[k.name] = x
+}
+
+// Unset removes the value of k.
+func Unset[T any](a Attributes, k Key[T]) {
+	delete(a, k.name)
+}
+
+// Has returns a filter that lets through the attributes with a value for k.
+func Has[T any](k Key[T]) func(Attributes) bool {
+	return func(a Attributes) bool {
+		_, ok := Get(a, k)
+		return ok
+	}
+}
+
+// Where returns a filter that lets through the attributes with a value for k
+// for which pred returns true.
+func Where[T any](k Key[T], pred func(T) bool) func(Attributes) bool {
+	return func(a Attributes) bool {
+		x, ok := Get(a, k)
+		return ok && pred(x)
+	}
+}
+
+// Graph attaches attributes to the vertices and edges of a WeightedGraph.
+// Algorithms take the underlying graph, or a Subgraph of it. Like a
+// LabeledGraph, it should be changed through its own methods so that the
+// attributes move along with the vertices and edges.
+type Graph[W graphs.Number] struct {
+	g        graphs.WeightedGraph[W]
+	vertices []Attributes
+	edges    map[[2]int]Attributes
+}
+
+// New wraps g, whose existing vertices and edges start without attributes.
+func New[W graphs.Number](g graphs.WeightedGraph[W]) *Graph[W] {
+	return &Graph[W]{g, make([]Attributes, g.Size()), map[[2]int]Attributes{}}
+}
+
+// Graph returns the underlying graph.
+func (p *Graph[W]) Graph() graphs.WeightedGraph[W] {
+	return p.g
+}
+
+func (p *Graph[W]) Size() int {
+	return p.g.Size()
+}
+
+func (p *Graph[W]) String() string {
+	b := strings.Builder{}
+	for v := 0; v < p.g.Size(); v++ {
+		fmt.Fprintf(&b, "%v %v:", v, p.vertices[v])
+		for _, u := range p.g.Neighbors(v) {
+			w, _ := p.g.Weight(v, u)
+			fmt.Fprintf(&b, " (--%v-> %v %v)", w, u, p.edges[p.key(v, u)])
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Vertex returns the attributes of v, which changes to the result update.
+func (p *Graph[W]) Vertex(v int) Attributes {
+	if p.vertices[v] == nil {
+		p.vertices[v] = Attributes{}
+	}
+	return p.vertices[v]
+}
+
+// Edge returns the attributes of the edge from src to dst, which changes to
+// the result update, or false if there is no such edge.
+func (p *Graph[W]) Edge(src, dst int) (Attributes, bool) {
+	if !p.g.Adjacent(src, dst) {
+		return nil, false
+	}
+	k := p.key(src, dst)
+	if p.edges[k] == nil {
+		p.edges[k] = Attributes{}
+	}
+	return p.edges[k], true
+}
+
+// AddVertex adds a vertex without attributes and returns its id.
+func (p *Graph[W]) AddVertex() int {
+	p.g.AddVertex()
+	p.vertices = append(p.vertices, nil)
+	return len(p.vertices) - 1
+}
+
+// RemoveVertex removes v with its edges and their attributes. Like
+// Graph.RemoveVertex, it moves every vertex with a higher id down by one;
+// their attributes move along.
+func (p *Graph[W]) RemoveVertex(v int) bool {
+	if !p.g.RemoveVertex(v) {
+		return false
+	}
+	p.vertices = append(p.vertices[:v], p.vertices[v+1:]...)
+	edges := make(map[[2]int]Attributes, len(p.edges))
+	for k, a := range p.edges {
+		if k[0] == v || k[1] == v {
+			continue
+		}
+		if k[0] > v {
+			k[0]--
+		}
+		if k[1] > v {
+			k[1]--
+		}
+		edges[k] = a
+	}
+	p.edges = edges
+	return true
+}
+
+// AddEdge adds an edge from src to dst, or changes its weight if it exists.
+// Its attributes are kept. It does not add parallel edges to a multigraph,
+// since they would share one set of attributes.
+func (p *Graph[W]) AddEdge(src, dst int, weight W) bool {
+	return p.g.UpdateEdge(src, dst, weight)
+}
+
+// RemoveEdge removes the edge from src to dst with its attributes.
+func (p *Graph[W]) RemoveEdge(src, dst int) bool {
+	if !p.g.RemoveEdge(src, dst) {
+		return false
+	}
+	delete(p.edges, p.key(src, dst))
+	return true
+}
+
+// Vertices returns the vertices whose attributes pass the filter. A nil filter
+// lets everything through.
+func (p *Graph[W]) Vertices(filter func(Attributes) bool) []int {
+	vs := []int{}
+	for v, a := range p.vertices {
+		if filter == nil || filter(a) {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+// Edges returns the edges whose attributes pass the filter, as pairs of
+// endpoints ordered by source. Each undirected edge is listed once, from its
+// lower end. A nil filter lets everything through.
+func (p *Graph[W]) Edges(filter func(Attributes) bool) [][2]int {
+	es := [][2]int{}
+	for u := 0; u < p.g.Size(); u++ {
+		for _, v := range p.g.Neighbors(u) {
+			if (!p.g.Undirected() || u <= v) && (filter == nil || filter(p.edges[p.key(u, v)])) {
+				es = append(es, [2]int{u, v})
+			}
+		}
+	}
+	return es
+}
+
+// Subgraph returns a view of the underlying graph with the vertices and edges
+// whose attributes pass the filters, on which any algorithm can run. A nil
+// filter lets everything through. The view follows later changes to the
+// graph and its attributes.
+func (p *Graph[W]) Subgraph(vertex, edge func(Attributes) bool) *views.Filtered[W] {
+	var vf func(int) bool
+	if vertex != nil {
+		vf = func(v int) bool { return vertex(p.vertices[v]) }
+	}
+	var ef func(int, int, W) bool
+	if edge != nil {
+		ef = func(src, dst int, _ W) bool { return edge(p.edges[p.key(src, dst)]) }
+	}
+	return views.Filter(p.g, vf, ef)
+}
+
+// key stores an undirected edge under its lower end.
+func (p *Graph[W]) key(src, dst int) [2]int {
+	if p.g.Undirected() && src > dst {
+		src, dst = dst, src
+	}
+	return [2]int{src, dst}
+}
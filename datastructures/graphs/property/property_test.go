@@ -0,0 +1,157 @@
+package property_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/multigraph"
+	"github.com/mhrdini/godsa/datastructures/graphs/property"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+var (
+	team     = property.NewKey[string]("team")
+	created  = property.NewKey[time.Time]("created")
+	deployed = property.NewKey[bool]("deployed")
+	protocol = property.NewKey[string]("protocol")
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+}
+
+// services is 0 -> 1 -> 3, 0 -> 2 -> 3 with 0 -> 2 over grpc and the rest
+// over http, and vertex 2 not yet deployed.
+func services() *property.Graph[float64] {
+	p := property.New(adjacencylist.NewWeighted[float64](graphs.Options{TotalVertices: 4}))
+	for v, t := range []string{"web", "core", "core", "data"} {
+		property.Set(p.Vertex(v), team, t)
+		property.Set(p.Vertex(v), created, day(v+1))
+		property.Set(p.Vertex(v), deployed, v != 2)
+	}
+	for _, e := range []struct {
+		src, dst int
+		w        float64
+		proto    string
+	}{{0, 1, 1.5, "http"}, {0, 2, 0.5, "grpc"}, {1, 3, 1, "http"}, {2, 3, 1, "http"}} {
+		p.AddEdge(e.src, e.dst, e.w)
+		a, _ := p.Edge(e.src, e.dst)
+		property.Set(a, protocol, e.proto)
+	}
+	return p
+}
+
+func TestAttributes(t *testing.T) {
+	p := services()
+	name, ok := property.Get(p.Vertex(1), team)
+	helpers.Assert(t, ok)
+	helpers.AssertEqual(t, name, "core")
+
+	// a key of another type under the same name does not match
+	_, ok = property.Get(p.Vertex(1), property.NewKey[int]("team"))
+	helpers.Assert(t, !ok)
+
+	a, ok := p.Edge(0, 2)
+	helpers.Assert(t, ok)
+	proto, _ := property.Get(a, protocol)
+	helpers.AssertEqual(t, proto, "grpc")
+	_, ok = p.Edge(3, 0)
+	helpers.Assert(t, !ok)
+
+	property.Unset(p.Vertex(1), team)
+	helpers.AssertEqual(t, helpers.ToString(p.Vertices(property.Has(team))), "[0 2 3]")
+}
+
+func TestFiltering(t *testing.T) {
+	p := services()
+	core := property.Where(team, func(s string) bool { return s == "core" })
+	helpers.AssertEqual(t, helpers.ToString(p.Vertices(core)), "[1 2]")
+	recent := property.Where(created, func(c time.Time) bool { return c.After(day(2)) })
+	helpers.AssertEqual(t, helpers.ToString(p.Vertices(recent)), "[2 3]")
+	http := property.Where(protocol, func(s string) bool { return s == "http" })
+	helpers.AssertEqual(t, helpers.ToString(p.Edges(http)), "[[0 1] [1 3] [2 3]]")
+
+	// a nil filter lets everything through, as in Subgraph
+	helpers.AssertEqual(t, helpers.ToString(p.Vertices(nil)), "[0 1 2 3]")
+	helpers.AssertEqual(t, helpers.ToString(p.Edges(nil)), "[[0 1] [0 2] [1 3] [2 3]]")
+}
+
+func TestMultigraph(t *testing.T) {
+	m := multigraph.NewWeighted[float64](graphs.Options{TotalVertices: 2})
+	p := property.New(m)
+	helpers.Assert(t, p.AddEdge(0, 1, 3))
+	a, _ := p.Edge(0, 1)
+	property.Set(a, protocol, "http")
+
+	// adding the edge again changes its weight instead of adding a parallel edge
+	helpers.Assert(t, !p.AddEdge(0, 1, 2))
+	helpers.AssertEqual(t, m.(*multigraph.Graph[float64]).TotalEdges(), 1)
+	w, _ := m.Weight(0, 1)
+	helpers.AssertEqual(t, w, 2.0)
+	a, _ = p.Edge(0, 1)
+	proto, _ := property.Get(a, protocol)
+	helpers.AssertEqual(t, proto, "http")
+}
+
+func TestSubgraph(t *testing.T) {
+	p := services()
+	helpers.AssertEqual(t, dijkstra.Run(p.Graph(), 0)[3].Dist, 1.5)
+
+	live := property.Where(deployed, func(d bool) bool { return d })
+	s := p.Subgraph(live, nil)
+	helpers.AssertEqual(t, helpers.ToString(s.Values()), "[0 1 3]")
+	helpers.AssertEqual(t, helpers.ToString(s.Neighbors(0)), "[1]")
+	helpers.AssertEqual(t, dijkstra.Run(s, 0)[3].Dist, 2.5)
+	helpers.Assert(t, math.IsInf(dijkstra.Run(s, 0)[2].Dist, 1))
+
+	http := property.Where(protocol, func(s string) bool { return s == "http" })
+	s = p.Subgraph(nil, http)
+	helpers.Assert(t, !s.Adjacent(0, 2))
+	helpers.AssertEqual(t, helpers.ToString(s.Transpose().Neighbors(3)), "[1 2]")
+	helpers.AssertEqual(t, helpers.ToString(s.Transpose().Neighbors(2)), "[]")
+
+	// the view follows changes to the attributes
+	a, _ := p.Edge(0, 2)
+	property.Set(a, protocol, "http")
+	helpers.Assert(t, s.Adjacent(0, 2))
+	helpers.AssertEqual(t, len(scc.Run(s)), 4)
+}
+
+func TestRemove(t *testing.T) {
+	p := services()
+	helpers.Assert(t, p.RemoveVertex(1))
+	name, _ := property.Get(p.Vertex(1), team)
+	helpers.AssertEqual(t, name, "core")
+	helpers.AssertEqual(t, helpers.ToString(p.Vertices(property.Has(team))), "[0 1 2]")
+	a, ok := p.Edge(1, 2)
+	helpers.Assert(t, ok)
+	proto, _ := property.Get(a, protocol)
+	helpers.AssertEqual(t, proto, "http")
+
+	helpers.Assert(t, p.RemoveEdge(0, 1))
+	helpers.Assert(t, !p.RemoveEdge(0, 1))
+	p.AddEdge(0, 1, 2)
+	a, _ = p.Edge(0, 1)
+	_, ok = property.Get(a, protocol)
+	helpers.Assert(t, !ok)
+
+	v := p.AddVertex()
+	helpers.AssertEqual(t, v, 3)
+	helpers.AssertEqual(t, len(p.Vertex(v)), 0)
+}
+
+func TestUndirected(t *testing.T) {
+	p := property.New(adjacencylist.New(graphs.Options{TotalVertices: 3, Undirected: true}))
+	p.AddEdge(2, 0, 1)
+	a, _ := p.Edge(0, 2)
+	property.Set(a, protocol, "tcp")
+	b, _ := p.Edge(2, 0)
+	proto, _ := property.Get(b, protocol)
+	helpers.AssertEqual(t, proto, "tcp")
+	helpers.AssertEqual(t, helpers.ToString(p.Edges(property.Has(protocol))), "[[0 2]]")
+}
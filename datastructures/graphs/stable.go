@@ -0,0 +1,174 @@
+package graphs
+
+import "fmt"
+
+// StableGraph wraps a WeightedGraph so that removing a vertex never changes
+// the ids of the others. A removed vertex is left behind as a tombstone with
+// no edges, and its id is handed out again by a later AddVertex, most recently
+// removed first. Size still counts tombstones, so algorithms that index slices
+// by id run on a StableGraph unchanged and see tombstones as isolated
+// vertices; Values lists only the live ones. Compact gets rid of the
+// tombstones once ids no longer need to be kept.
+type StableGraph[W Number] struct {
+	g    WeightedGraph[W]
+	dead []bool
+	free []int // ids of tombstones, reused from the end
+}
+
+// NewStable wraps g, whose existing vertices all stay live.
+func NewStable[W Number](g WeightedGraph[W]) *StableGraph[W] {
+	return &StableGraph[W]{g, make([]bool, g.Size()), []int{}}
+}
+
+// Graph returns the underlying graph, in which tombstones are vertices without
+// edges. Changing it bypasses the tombstones.
+func (s *StableGraph[W]) Graph() WeightedGraph[W] {
+	return s.g
+}
+
+func (s *StableGraph[W]) Name() string {
+	return "Stable" + s.g.Name()
+}
+
+// Size returns the number of ids in use, counting tombstones.
+func (s *StableGraph[W]) Size() int {
+	return len(s.dead)
+}
+
+func (s *StableGraph[W]) Empty() bool {
+	return s.g.Empty()
+}
+
+// Values returns the ids of the live vertices.
+func (s *StableGraph[W]) Values() []int {
+	vs := []int{}
+	for v, dead := range s.dead {
+		if !dead {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+func (s *StableGraph[W]) String() string {
+	return fmt.Sprintf("removed: %v\n%v", s.free, s.g)
+}
+
+// Reset removes every edge. Tombstones stay.
+func (s *StableGraph[W]) Reset() {
+	s.g.Reset()
+}
+
+// Alive reports whether v is a vertex that has not been removed.
+func (s *StableGraph[W]) Alive(v int) bool {
+	return v >= 0 && v < len(s.dead) && !s.dead[v]
+}
+
+func (s *StableGraph[W]) Adjacent(v1, v2 int) bool {
+	return s.Alive(v1) && s.Alive(v2) && s.g.Adjacent(v1, v2)
+}
+
+func (s *StableGraph[W]) Neighbors(v int) []int {
+	return s.g.Neighbors(v)
+}
+
+func (s *StableGraph[W]) Undirected() bool {
+	return s.g.Undirected()
+}
+
+func (s *StableGraph[W]) Transpose() WeightedGraph[W] {
+	if s.g.Undirected() {
+		return s
+	}
+	return &StableGraph[W]{
+		g:    s.g.Transpose(),
+		dead: append([]bool{}, s.dead...),
+		free: append([]int{}, s.free...),
+	}
+}
+
+func (s *StableGraph[W]) Weight(src, dst int) (W, bool) {
+	if !s.Alive(src) || !s.Alive(dst) {
+		return 0, false
+	}
+	return s.g.Weight(src, dst)
+}
+
+func (s *StableGraph[W]) AddVertex() {
+	s.NewVertex()
+}
+
+// NewVertex adds a vertex and returns its id, which is that of the most
+// recently removed vertex if there is one.
+func (s *StableGraph[W]) NewVertex() int {
+	if n := len(s.free); n > 0 {
+		v := s.free[n-1]
+		s.free = s.free[:n-1]
+		s.dead[v] = false
+		return v
+	}
+	s.g.AddVertex()
+	s.dead = append(s.dead, false)
+	return len(s.dead) - 1
+}
+
+// RemoveVertex removes the edges of v and turns it into a tombstone. No other
+// id changes.
+func (s *StableGraph[W]) RemoveVertex(v int) bool {
+	if !s.Alive(v) {
+		return false
+	}
+	for _, u := range s.g.Neighbors(v) {
+		s.g.RemoveEdge(v, u)
+	}
+	if !s.g.Undirected() {
+		for u := range s.dead {
+			if s.g.Adjacent(u, v) {
+				s.g.RemoveEdge(u, v)
+			}
+		}
+	}
+	s.dead[v] = true
+	s.free = append(s.free, v)
+	return true
+}
+
+// AddEdge adds an edge between two live vertices.
+func (s *StableGraph[W]) AddEdge(src, dst int, weight W) bool {
+	return s.Alive(src) && s.Alive(dst) && s.g.AddEdge(src, dst, weight)
+}
+
+// UpdateEdge sets the weight of an edge between two live vertices, adding it
+// if it is missing.
+func (s *StableGraph[W]) UpdateEdge(src, dst int, weight W) bool {
+	return s.Alive(src) && s.Alive(dst) && s.g.UpdateEdge(src, dst, weight)
+}
+
+func (s *StableGraph[W]) RemoveEdge(src, dst int) bool {
+	return s.Alive(src) && s.Alive(dst) && s.g.RemoveEdge(src, dst)
+}
+
+// Compact removes the tombstones from the underlying graph, renumbering the
+// live vertices to 0..n-1 in their current order. It returns the new id of
+// every old one, or -1 for a tombstone.
+func (s *StableGraph[W]) Compact() []int {
+	ids := make([]int, len(s.dead))
+	next := 0
+	for v, dead := range s.dead {
+		if dead {
+			ids[v] = -1
+			continue
+		}
+		ids[v] = next
+		next++
+	}
+	// removing from the highest id down leaves the lower ones in place
+	for v := len(s.dead) - 1; v >= 0; v-- {
+		if s.dead[v] {
+			s.g.RemoveVertex(v)
+		}
+	}
+	s.dead = make([]bool, next)
+	s.free = []int{}
+	return ids
+}
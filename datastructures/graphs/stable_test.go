@@ -0,0 +1,238 @@
+package graphs_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymap"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencymatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/bitmatrix"
+	"github.com/mhrdini/godsa/datastructures/graphs/edgelist"
+	"github.com/mhrdini/godsa/datastructures/graphs/multigraph"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+// model is a reference graph kept as a set of weighted edges. Undirected edges
+// are stored once, from the lower id. Edges of an unweighted model weigh 1.
+type model struct {
+	undirected bool
+	unweighted bool
+	dead       []bool
+	free       []int
+	edges      map[[2]int]int
+}
+
+func newModel(n int, c constructor, undirected bool) *model {
+	return &model{undirected, c.unweighted, make([]bool, n), []int{}, map[[2]int]int{}}
+}
+
+func (m *model) key(u, v int) [2]int {
+	if m.undirected && u > v {
+		u, v = v, u
+	}
+	return [2]int{u, v}
+}
+
+func (m *model) alive(v int) bool {
+	return v >= 0 && v < len(m.dead) && !m.dead[v]
+}
+
+// renumber keeps the vertices for which keep is true and moves the rest out,
+// like Graph.RemoveVertex does one vertex at a time.
+func (m *model) renumber(keep func(v int) bool) []int {
+	ids := make([]int, len(m.dead))
+	next := 0
+	for v := range m.dead {
+		ids[v] = -1
+		if keep(v) {
+			ids[v] = next
+			next++
+		}
+	}
+	edges := map[[2]int]int{}
+	for e, w := range m.edges {
+		if ids[e[0]] >= 0 && ids[e[1]] >= 0 {
+			edges[m.key(ids[e[0]], ids[e[1]])] = w
+		}
+	}
+	m.dead, m.free, m.edges = make([]bool, next), []int{}, edges
+	return ids
+}
+
+func (m *model) dropEdges(v int) {
+	for e := range m.edges {
+		if e[0] == v || e[1] == v {
+			delete(m.edges, e)
+		}
+	}
+}
+
+// check compares every observable part of g with m.
+func check(t *testing.T, g graphs.Graph, m *model) {
+	t.Helper()
+	n := len(m.dead)
+	helpers.AssertEqual(t, g.Size(), n)
+	helpers.AssertEqual(t, g.Empty(), len(m.edges) == 0)
+	live := []int{}
+	for v := range n {
+		if !m.dead[v] {
+			live = append(live, v)
+		}
+	}
+	helpers.AssertEqual(t, helpers.ToString(g.Values()), helpers.ToString(live))
+	for u := range n {
+		neighbors := []int{}
+		for v := range n {
+			want, ok := m.edges[m.key(u, v)]
+			helpers.AssertEqual(t, g.Adjacent(u, v), ok)
+			w, found := g.Weight(u, v)
+			helpers.AssertEqual(t, found, ok)
+			helpers.AssertEqual(t, w, want)
+			if ok {
+				neighbors = append(neighbors, v)
+			}
+		}
+		got := g.Neighbors(u)
+		slices.Sort(got)
+		helpers.AssertEqual(t, helpers.ToString(got), helpers.ToString(neighbors))
+	}
+}
+
+type constructor struct {
+	name       string
+	new        func(graphs.Options) graphs.Graph
+	unweighted bool
+}
+
+var constructors = []constructor{
+	{"AdjacencyList", adjacencylist.New, false},
+	{"AdjacencyMatrix", adjacencymatrix.New, false},
+	{"AdjacencyMap", adjacencymap.New, false},
+	{"BitMatrix", bitmatrix.New, true},
+	{"MultiGraph", multigraph.New, false},
+	{"EdgeList", edgelist.New, false},
+}
+
+// operate applies a random edge operation to both g and m.
+func operate(t *testing.T, rng *rand.Rand, g graphs.Graph, m *model) {
+	t.Helper()
+	n := len(m.dead)
+	if n == 0 {
+		return
+	}
+	u, v := rng.Intn(n), rng.Intn(n)
+	_, had := m.edges[m.key(u, v)]
+	ok := m.alive(u) && m.alive(v)
+	if rng.Intn(3) == 0 {
+		helpers.AssertEqual(t, g.RemoveEdge(u, v), ok && had)
+		delete(m.edges, m.key(u, v))
+		return
+	}
+	w := 1 + rng.Intn(9)
+	helpers.AssertEqual(t, g.UpdateEdge(u, v, w), ok && !had)
+	if ok && m.unweighted {
+		m.edges[m.key(u, v)] = 1
+	} else if ok {
+		m.edges[m.key(u, v)] = w
+	}
+}
+
+func TestRemoveVertexAndEdge(t *testing.T) {
+	for _, c := range constructors {
+		for _, undirected := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%v undirected=%v", c.name, undirected), func(t *testing.T) {
+				rng := rand.New(rand.NewSource(1))
+				for trial := 0; trial < 20; trial++ {
+					n := rng.Intn(8)
+					g := c.new(graphs.Options{TotalVertices: uint32(n), Undirected: undirected})
+					m := newModel(n, c, undirected)
+					for step := 0; step < 60; step++ {
+						switch r := rng.Intn(10); {
+						case r == 0:
+							g.AddVertex()
+							m.dead = append(m.dead, false)
+						case r == 1 && len(m.dead) > 0:
+							v := rng.Intn(len(m.dead))
+							helpers.Assert(t, g.RemoveVertex(v))
+							m.renumber(func(u int) bool { return u != v })
+						default:
+							operate(t, rng, g, m)
+						}
+						check(t, g, m)
+					}
+					helpers.Assert(t, !g.RemoveVertex(len(m.dead)))
+				}
+			})
+		}
+	}
+}
+
+func TestStableGraph(t *testing.T) {
+	for _, c := range constructors {
+		for _, undirected := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%v undirected=%v", c.name, undirected), func(t *testing.T) {
+				rng := rand.New(rand.NewSource(2))
+				for trial := 0; trial < 20; trial++ {
+					n := rng.Intn(8)
+					s := graphs.NewStable(c.new(graphs.Options{TotalVertices: uint32(n), Undirected: undirected}))
+					m := newModel(n, c, undirected)
+					for step := 0; step < 80; step++ {
+						switch r := rng.Intn(12); {
+						case r == 0:
+							want := len(m.dead)
+							if k := len(m.free); k > 0 {
+								want, m.free = m.free[k-1], m.free[:k-1]
+								m.dead[want] = false
+							} else {
+								m.dead = append(m.dead, false)
+							}
+							helpers.AssertEqual(t, s.NewVertex(), want)
+						case r <= 2 && len(m.dead) > 0:
+							v := rng.Intn(len(m.dead))
+							helpers.AssertEqual(t, s.RemoveVertex(v), m.alive(v))
+							if m.alive(v) {
+								m.dropEdges(v)
+								m.dead[v] = true
+								m.free = append(m.free, v)
+							}
+						case r == 3:
+							ids := m.renumber(m.alive)
+							helpers.AssertEqual(t, helpers.ToString(s.Compact()), helpers.ToString(ids))
+							check(t, s.Graph(), m)
+						default:
+							operate(t, rng, s, m)
+						}
+						check(t, s, m)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestStableGraphIDs(t *testing.T) {
+	s := graphs.NewStable(adjacencylist.New(graphs.Options{TotalVertices: 4}))
+	s.AddEdge(0, 1, 1)
+	s.AddEdge(1, 2, 2)
+	s.AddEdge(2, 3, 3)
+	s.AddEdge(3, 1, 4)
+
+	helpers.Assert(t, s.RemoveVertex(1))
+	helpers.Assert(t, !s.RemoveVertex(1))
+	helpers.Assert(t, !s.Alive(1))
+	helpers.Assert(t, !s.AddEdge(0, 1, 1))
+	helpers.AssertEqual(t, s.Size(), 4)
+	helpers.AssertEqual(t, helpers.ToString(s.Values()), "[0 2 3]")
+	helpers.AssertEqual(t, helpers.ToString(s.Neighbors(2)), "[3]")
+	w, _ := s.Weight(2, 3)
+	helpers.AssertEqual(t, w, 3)
+
+	helpers.AssertEqual(t, helpers.ToString(s.Compact()), "[0 -1 1 2]")
+	helpers.AssertEqual(t, s.Size(), 3)
+	helpers.AssertEqual(t, helpers.ToString(s.Neighbors(1)), "[2]")
+	helpers.AssertEqual(t, s.NewVertex(), 3)
+}
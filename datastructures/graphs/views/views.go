@@ -0,0 +1,357 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mhrdini/godsa/datastructures/graphs"
+)
+
+// A view shows a graph differently without copying it. It reads the graph it
+// is made from on every call, so it sees changes made to that graph, and it
+// cannot be changed itself: AddVertex and Reset do nothing and the other
+// methods that would change it report false. Vertices keep their ids, so
+// results of algorithms run on a view index the same way as on the graph.
+
+// Filtered is the view of a graph with only the vertices and edges that pass
+// a filter. A vertex that does not pass keeps its id but has no edges, and is
+// left out of Values.
+type Filtered[W graphs.Number] struct {
+	g      graphs.WeightedGraph[W]
+	vertex func(v int) bool
+	edge   func(src, dst int, weight W) bool
+}
+
+// Filter returns the view of g with the vertices for which vertex returns
+// true and the edges between them for which edge returns true. A nil filter
+// lets everything through. On an undirected graph, edge must not depend on
+// which end of an edge is src.
+func Filter[W graphs.Number](g graphs.WeightedGraph[W], vertex func(v int) bool, edge func(src, dst int, weight W) bool) *Filtered[W] {
+	if vertex == nil {
+		vertex = func(int) bool { return true }
+	}
+	if edge == nil {
+		edge = func(int, int, W) bool { return true }
+	}
+	return &Filtered[W]{g, vertex, edge}
+}
+
+func (f *Filtered[W]) Name() string {
+	return "Filtered" + f.g.Name()
+}
+
+func (f *Filtered[W]) Size() int {
+	return f.g.Size()
+}
+
+func (f *Filtered[W]) Empty() bool {
+	for u := 0; u < f.Size(); u++ {
+		if len(f.Neighbors(u)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Values returns the vertices that pass the filter.
+func (f *Filtered[W]) Values() []int {
+	vs := []int{}
+	for v := 0; v < f.Size(); v++ {
+		if f.vertex(v) {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+// Includes reports whether v is a vertex of g that passes the filter.
+func (f *Filtered[W]) Includes(v int) bool {
+	return v >= 0 && v < f.g.Size() && f.vertex(v)
+}
+
+func (f *Filtered[W]) String() string {
+	return describe(f)
+}
+
+func (f *Filtered[W]) Reset() {}
+
+func (f *Filtered[W]) Adjacent(v1, v2 int) bool {
+	_, ok := f.Weight(v1, v2)
+	return ok
+}
+
+func (f *Filtered[W]) Neighbors(v int) []int {
+	vs := []int{}
+	if !f.vertex(v) {
+		return vs
+	}
+	for _, u := range f.g.Neighbors(v) {
+		if w, _ := f.g.Weight(v, u); f.vertex(u) && f.edge(v, u, w) {
+			vs = append(vs, u)
+		}
+	}
+	return vs
+}
+
+// Transpose returns the same filter on the reversed view of the graph, so
+// that it too sees changes made to the graph.
+func (f *Filtered[W]) Transpose() graphs.WeightedGraph[W] {
+	if f.g.Undirected() {
+		return f
+	}
+	edge := f.edge
+	return Filter(Reverse(f.g), f.vertex, func(src, dst int, weight W) bool { return edge(dst, src, weight) })
+}
+
+func (f *Filtered[W]) Weight(src, dst int) (W, bool) {
+	if !f.vertex(src) || !f.vertex(dst) {
+		return 0, false
+	}
+	if w, ok := f.g.Weight(src, dst); ok && f.edge(src, dst, w) {
+		return w, true
+	}
+	return 0, false
+}
+
+func (f *Filtered[W]) Undirected() bool {
+	return f.g.Undirected()
+}
+
+func (f *Filtered[W]) AddVertex() {}
+
+func (f *Filtered[W]) RemoveVertex(v int) bool {
+	return false
+}
+
+func (f *Filtered[W]) AddEdge(src, dst int, weight W) bool {
+	return false
+}
+
+func (f *Filtered[W]) UpdateEdge(src, dst int, weight W) bool {
+	return false
+}
+
+func (f *Filtered[W]) RemoveEdge(src, dst int) bool {
+	return false
+}
+
+// Induced returns the view of g with only the given vertices and the edges
+// between them. Ids that are not vertices of g are skipped.
+//
+// Like every view it keeps the ids and the Size of g, so an algorithm that
+// runs over every vertex also returns the excluded ones, as isolated
+// vertices: scc.Run gives each of them a component of its own. Includes tells
+// them apart from the vertices of the induced subgraph.
+func Induced[W graphs.Number](g graphs.WeightedGraph[W], vertices ...int) *Filtered[W] {
+	in := make([]bool, g.Size())
+	for _, v := range vertices {
+		if v >= 0 && v < len(in) {
+			in[v] = true
+		}
+	}
+	return Filter(g, func(v int) bool { return v >= 0 && v < len(in) && in[v] }, nil)
+}
+
+// FilterEdges returns the view of g with only the edges for which edge
+// returns true.
+func FilterEdges[W graphs.Number](g graphs.WeightedGraph[W], edge func(src, dst int, weight W) bool) *Filtered[W] {
+	return Filter(g, nil, edge)
+}
+
+// Reversed is the view of a directed graph with every edge turned around.
+// Unlike Transpose it does not copy the graph, but Neighbors has to ask every
+// vertex whether it has an edge to v, in O(V) calls to Adjacent.
+type Reversed[W graphs.Number] struct {
+	g graphs.WeightedGraph[W]
+}
+
+// Reverse returns the reversed view of g. An undirected graph is its own
+// reverse.
+func Reverse[W graphs.Number](g graphs.WeightedGraph[W]) graphs.WeightedGraph[W] {
+	if g.Undirected() {
+		return g
+	}
+	if r, ok := g.(*Reversed[W]); ok {
+		return r.g
+	}
+	return &Reversed[W]{g}
+}
+
+func (r *Reversed[W]) Name() string {
+	return "Reversed" + r.g.Name()
+}
+
+func (r *Reversed[W]) Size() int {
+	return r.g.Size()
+}
+
+func (r *Reversed[W]) Empty() bool {
+	return r.g.Empty()
+}
+
+func (r *Reversed[W]) Values() []int {
+	return r.g.Values()
+}
+
+func (r *Reversed[W]) String() string {
+	return describe(r)
+}
+
+func (r *Reversed[W]) Reset() {}
+
+func (r *Reversed[W]) Adjacent(v1, v2 int) bool {
+	return r.g.Adjacent(v2, v1)
+}
+
+func (r *Reversed[W]) Neighbors(v int) []int {
+	return predecessors(r.g, v)
+}
+
+// Transpose returns the graph r is a view of.
+func (r *Reversed[W]) Transpose() graphs.WeightedGraph[W] {
+	return r.g
+}
+
+func (r *Reversed[W]) Weight(src, dst int) (W, bool) {
+	return r.g.Weight(dst, src)
+}
+
+func (r *Reversed[W]) Undirected() bool {
+	return false
+}
+
+func (r *Reversed[W]) AddVertex() {}
+
+func (r *Reversed[W]) RemoveVertex(v int) bool {
+	return false
+}
+
+func (r *Reversed[W]) AddEdge(src, dst int, weight W) bool {
+	return false
+}
+
+func (r *Reversed[W]) UpdateEdge(src, dst int, weight W) bool {
+	return false
+}
+
+func (r *Reversed[W]) RemoveEdge(src, dst int) bool {
+	return false
+}
+
+// Undirected is the view of a directed graph with every edge usable both
+// ways. Where there are edges in both directions between two vertices, it has
+// one edge with the smaller of their weights. Like Reversed, it finds the
+// edges into v in O(V) calls to Adjacent.
+type Undirected[W graphs.Number] struct {
+	g graphs.WeightedGraph[W]
+}
+
+// AsUndirected returns the undirected view of g, or g if it is undirected.
+func AsUndirected[W graphs.Number](g graphs.WeightedGraph[W]) graphs.WeightedGraph[W] {
+	if g.Undirected() {
+		return g
+	}
+	return &Undirected[W]{g}
+}
+
+func (u *Undirected[W]) Name() string {
+	return "Undirected" + u.g.Name()
+}
+
+func (u *Undirected[W]) Size() int {
+	return u.g.Size()
+}
+
+func (u *Undirected[W]) Empty() bool {
+	return u.g.Empty()
+}
+
+func (u *Undirected[W]) Values() []int {
+	return u.g.Values()
+}
+
+func (u *Undirected[W]) String() string {
+	return describe(u)
+}
+
+func (u *Undirected[W]) Reset() {}
+
+func (u *Undirected[W]) Adjacent(v1, v2 int) bool {
+	return u.g.Adjacent(v1, v2) || u.g.Adjacent(v2, v1)
+}
+
+// Neighbors returns the vertices v has an edge to, followed by the ones that
+// only have an edge to v.
+func (u *Undirected[W]) Neighbors(v int) []int {
+	vs := u.g.Neighbors(v)
+	for _, w := range predecessors(u.g, v) {
+		if !u.g.Adjacent(v, w) {
+			vs = append(vs, w)
+		}
+	}
+	return vs
+}
+
+func (u *Undirected[W]) Transpose() graphs.WeightedGraph[W] {
+	return u
+}
+
+func (u *Undirected[W]) Weight(src, dst int) (W, bool) {
+	w1, ok1 := u.g.Weight(src, dst)
+	w2, ok2 := u.g.Weight(dst, src)
+	switch {
+	case ok1 && ok2:
+		return min(w1, w2), true
+	case ok2:
+		return w2, true
+	}
+	return w1, ok1
+}
+
+func (u *Undirected[W]) Undirected() bool {
+	return true
+}
+
+func (u *Undirected[W]) AddVertex() {}
+
+func (u *Undirected[W]) RemoveVertex(v int) bool {
+	return false
+}
+
+func (u *Undirected[W]) AddEdge(src, dst int, weight W) bool {
+	return false
+}
+
+func (u *Undirected[W]) UpdateEdge(src, dst int, weight W) bool {
+	return false
+}
+
+func (u *Undirected[W]) RemoveEdge(src, dst int) bool {
+	return false
+}
+
+// predecessors returns the vertices with an edge to v, in increasing order.
+func predecessors(g graphs.Topology, v int) []int {
+	vs := []int{}
+	for u := 0; u < g.Size(); u++ {
+		if g.Adjacent(u, v) {
+			vs = append(vs, u)
+		}
+	}
+	return vs
+}
+
+// describe lists the edges of a view like the String of an adjacency list.
+func describe[W graphs.Number](g graphs.WeightedGraph[W]) string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "%v of %v vertices, undirected: %v\n", g.Name(), g.Size(), g.Undirected())
+	for u := 0; u < g.Size(); u++ {
+		fmt.Fprintf(&b, "%v:", u)
+		for _, v := range g.Neighbors(u) {
+			w, _ := g.Weight(u, v)
+			fmt.Fprintf(&b, " (--%v-> %v)", w, v)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
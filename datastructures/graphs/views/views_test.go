@@ -0,0 +1,167 @@
+package views_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/mhrdini/godsa/algorithms/graphs/bfs"
+	"github.com/mhrdini/godsa/algorithms/graphs/dijkstra"
+	"github.com/mhrdini/godsa/algorithms/graphs/scc"
+	"github.com/mhrdini/godsa/datastructures/graphs"
+	"github.com/mhrdini/godsa/datastructures/graphs/adjacencylist"
+	"github.com/mhrdini/godsa/datastructures/graphs/views"
+	"github.com/mhrdini/godsa/helpers"
+)
+
+func random(rng *rand.Rand, n, m int, undirected bool) graphs.Graph {
+	g := adjacencylist.New(graphs.Options{TotalVertices: uint32(n), Undirected: undirected})
+	for i := 0; i < m; i++ {
+		g.UpdateEdge(rng.Intn(n), rng.Intn(n), 1+rng.Intn(9))
+	}
+	return g
+}
+
+// same checks that the view v has the edges of the graph g.
+func same(t *testing.T, v, g graphs.Graph) {
+	t.Helper()
+	helpers.AssertEqual(t, v.Size(), g.Size())
+	helpers.AssertEqual(t, v.Undirected(), g.Undirected())
+	helpers.AssertEqual(t, v.Empty(), g.Empty())
+	for a := 0; a < g.Size(); a++ {
+		got, want := v.Neighbors(a), g.Neighbors(a)
+		slices.Sort(got)
+		slices.Sort(want)
+		helpers.AssertEqual(t, helpers.ToString(got), helpers.ToString(want))
+		for b := 0; b < g.Size(); b++ {
+			helpers.AssertEqual(t, v.Adjacent(a, b), g.Adjacent(a, b))
+			w1, ok1 := v.Weight(a, b)
+			w2, ok2 := g.Weight(a, b)
+			helpers.AssertEqual(t, ok1, ok2)
+			helpers.AssertEqual(t, w1, w2)
+		}
+	}
+}
+
+func TestInduced(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 20; trial++ {
+		n := 1 + rng.Intn(15)
+		g := random(rng, n, 3*n, trial%2 == 0)
+		keep := []int{}
+		want := adjacencylist.New(graphs.Options{TotalVertices: uint32(n), Undirected: g.Undirected()})
+		for v := 0; v < n; v++ {
+			if rng.Intn(2) == 0 {
+				keep = append(keep, v)
+			}
+		}
+		for _, a := range keep {
+			for _, b := range g.Neighbors(a) {
+				if slices.Contains(keep, b) {
+					w, _ := g.Weight(a, b)
+					want.UpdateEdge(a, b, w)
+				}
+			}
+		}
+		induced := views.Induced(g, keep...)
+		same(t, induced, want)
+		helpers.AssertEqual(t, helpers.ToString(induced.Values()), helpers.ToString(keep))
+		same(t, induced.Transpose(), want.Transpose())
+	}
+}
+
+func TestInducedAlgorithm(t *testing.T) {
+	// the cycle 0 -> 1 -> 2 -> 0 with 3 hanging off it, and 4 alone
+	g := adjacencylist.New(graphs.Options{TotalVertices: 5})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 0, 1)
+	g.AddEdge(2, 3, 1)
+
+	// ids that are not vertices are skipped
+	induced := views.Induced(g, 1, 2, 3, 5, -1)
+	helpers.AssertEqual(t, helpers.ToString(induced.Values()), "[1 2 3]")
+	helpers.Assert(t, !induced.Includes(5))
+
+	// without 0 the cycle is broken, and 0 and 4 are still returned, alone
+	components := scc.Run(induced)
+	helpers.AssertEqual(t, len(components), 5)
+	kept := [][]int{}
+	for _, c := range components {
+		if induced.Includes(c[0]) {
+			kept = append(kept, c)
+		}
+	}
+	slices.SortFunc(kept, func(a, b []int) int { return a[0] - b[0] })
+	helpers.AssertEqual(t, helpers.ToString(kept), "[[1] [2] [3]]")
+}
+
+func TestFilterEdges(t *testing.T) {
+	g := random(rand.New(rand.NewSource(2)), 10, 30, false)
+	light := views.FilterEdges(g, func(src, dst, w int) bool { return w <= 4 })
+	for u := 0; u < 10; u++ {
+		for _, v := range g.Neighbors(u) {
+			w, _ := g.Weight(u, v)
+			helpers.AssertEqual(t, light.Adjacent(u, v), w <= 4)
+		}
+	}
+	same(t, views.FilterEdges(g, func(src, dst, w int) bool { return true }), g)
+
+	// the source of each edge is passed as src, including on the transpose
+	out := views.FilterEdges(g, func(src, dst, w int) bool { return src == 0 })
+	helpers.AssertEqual(t, helpers.ToString(out.Neighbors(0)), helpers.ToString(g.Neighbors(0)))
+	for _, v := range g.Neighbors(0) {
+		helpers.Assert(t, out.Transpose().Adjacent(v, 0))
+	}
+}
+
+func TestFilteredTranspose(t *testing.T) {
+	g := adjacencylist.New(graphs.Options{TotalVertices: 4})
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 3, 1)
+	transposed := views.Induced(g, 0, 1, 2).Transpose()
+	helpers.AssertEqual(t, helpers.ToString(transposed.Neighbors(1)), "[0]")
+	helpers.AssertEqual(t, len(transposed.Neighbors(3)), 0)
+
+	// the transpose shares storage with g
+	g.AddEdge(2, 1, 1)
+	helpers.AssertEqual(t, helpers.ToString(transposed.Neighbors(1)), "[0 2]")
+	helpers.Assert(t, transposed.Adjacent(1, 2))
+}
+
+func TestReverse(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	g := random(rng, 12, 40, false)
+	r := views.Reverse(g)
+	same(t, r, g.Transpose())
+	same(t, r.Transpose(), g)
+	helpers.Assert(t, views.Reverse(r) == g)
+
+	// the view shares storage with g
+	g.AddEdge(5, 7, 3)
+	helpers.Assert(t, r.Adjacent(7, 5))
+	helpers.Assert(t, !r.AddEdge(0, 1, 1))
+
+	u := random(rng, 5, 5, true)
+	helpers.Assert(t, views.Reverse(u) == u)
+}
+
+func TestAsUndirected(t *testing.T) {
+	g := adjacencylist.New(graphs.Options{TotalVertices: 4})
+	g.AddEdge(0, 1, 5)
+	g.AddEdge(1, 0, 2)
+	g.AddEdge(2, 1, 7)
+	g.AddEdge(3, 3, 1)
+
+	want := adjacencylist.New(graphs.Options{TotalVertices: 4, Undirected: true})
+	want.AddEdge(0, 1, 2)
+	want.AddEdge(1, 2, 7)
+	want.AddEdge(3, 3, 1)
+	u := views.AsUndirected(g)
+	same(t, u, want)
+	helpers.AssertEqual(t, helpers.ToString(u.Neighbors(1)), "[0 2]")
+
+	// vertex 0 only reaches 2 if edges can be followed backwards
+	helpers.AssertEqual(t, dijkstra.Run(u, 0)[2].Dist, 9.0)
+	helpers.AssertEqual(t, bfs.Run(views.Reverse(g), 1)[2].Dist, 1.0)
+}
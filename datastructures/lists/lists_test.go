@@ -238,7 +238,7 @@ func TestGet(t *testing.T) {
 		})
 	}
 
-	arbitrary := []int{1, 2, 3}
+	arbitrary := []int{1, 2, 3, 4, 5}
 
 	type result struct {
 		value int
@@ -254,7 +254,9 @@ func TestGet(t *testing.T) {
 		{0, result{arbitrary[0], true}},
 		{1, result{arbitrary[1], true}},
 		{2, result{arbitrary[2], true}},
-		{3, result{zeroValue, false}},
+		{3, result{arbitrary[3], true}},
+		{4, result{arbitrary[4], true}},
+		{5, result{zeroValue, false}},
 	}
 
 	for _, tc := range testCases {
@@ -286,7 +286,7 @@ func (l *List[T]) Get(i int) (T, bool) {
 		return value, false
 	default:
 		curr := l.head
-		for pos := 0; pos < i; pos = pos + i {
+		for pos := 0; pos < i; pos++ {
 			curr = curr.next
 		}
 		return curr.value, true
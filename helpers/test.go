@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -19,6 +20,13 @@ func AssertEqual[T comparable](t testing.TB, got, want T) {
 	}
 }
 
+func AssertInDelta(t testing.TB, got, want, delta float64) {
+	t.Helper()
+	if math.Abs(got-want) > delta {
+		t.Errorf("got %v want %v (±%v)", got, want, delta)
+	}
+}
+
 func ToString[T any](values T) string {
 	return fmt.Sprintf("%v", values)
 }